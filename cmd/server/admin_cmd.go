@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/member"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"golang.org/x/term"
+)
+
+// Same limits as the signup and login requests, so the admin can log in with it
+const (
+	adminPasswordMinLength = 8
+	adminPasswordMaxLength = 15
+)
+
+// runCreateAdminCommand creates an admin account, which can call the admin API (/api/v1/admin) with its
+// access token. The password is read from the terminal without echo
+// (asked twice), or from the first line of stdin when it is not a terminal.
+//
+// Usage:
+//
+//	server create-admin -email=admin@example.com -name=관리자 -phone=010-0000-0000 [-env=local]
+//	echo "$ADMIN_PASSWORD" | server create-admin -email=admin@example.com -phone=010-0000-0000
+func runCreateAdminCommand(args []string) error {
+	fs, opts := newFlagSet("create-admin")
+	email := fs.String("email", "", "Admin email (required)")
+	name := fs.String("name", "관리자", "Admin name")
+	phone := fs.String("phone", "", "Admin phone number (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if address, err := mail.ParseAddress(*email); err != nil || address.Address != *email {
+		return fmt.Errorf("올바른 이메일을 입력하세요: -email=%q", *email)
+	}
+	if strings.TrimSpace(*phone) == "" || strings.TrimSpace(*name) == "" {
+		return errors.New("-name, -phone 값이 필요합니다")
+	}
+
+	password, err := readPassword(os.Stdin, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	return withDatabase(*opts, true, func(cfg *config.Config, db *database.DB) error {
+		memberService := member.NewMemberService(db.DB, member.NewMemberRepository())
+		admin, err := memberService.CreateAdmin(context.Background(), *name, *email, *phone, password)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stdout, "관리자 계정 생성 완료: id=%d email=%s env=%s\n", admin.ID, admin.Email, cfg.App.Env)
+		return nil
+	})
+}
+
+// readPassword prompts for the password on a terminal, or reads a line from a pipe
+func readPassword(in *os.File, prompt io.Writer) (string, error) {
+	var password string
+	if fd := int(in.Fd()); term.IsTerminal(fd) {
+		first, err := promptPassword(fd, prompt, "비밀번호: ")
+		if err != nil {
+			return "", err
+		}
+		second, err := promptPassword(fd, prompt, "비밀번호 확인: ")
+		if err != nil {
+			return "", err
+		}
+		if first != second {
+			return "", errors.New("비밀번호가 일치하지 않습니다")
+		}
+		password = first
+	} else {
+		line, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("비밀번호 읽기 실패: %w", err)
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	if n := utf8.RuneCountInString(password); n < adminPasswordMinLength || n > adminPasswordMaxLength {
+		return "", fmt.Errorf("비밀번호는 %d~%d자여야 합니다", adminPasswordMinLength, adminPasswordMaxLength)
+	}
+	return password, nil
+}
+
+func promptPassword(fd int, prompt io.Writer, label string) (string, error) {
+	fmt.Fprint(prompt, label)
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(prompt)
+	if err != nil {
+		return "", fmt.Errorf("비밀번호 읽기 실패: %w", err)
+	}
+	return string(password), nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+)
+
+// command is a subcommand of the server binary
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+// commands lists the subcommands; "serve" runs when none is given
+var commands = []command{
+	{name: "serve", summary: "HTTP 서버 실행 (기본)", run: runServeCommand},
+	{name: "migrate", summary: "테이블 생성/컬럼 추가 (-reset: 전체 재생성, prod 불가)", run: runMigrateCommand},
+	{name: "seed", summary: "개발용 데이터 입력 (prod 불가)", run: runSeedCommand},
+	{name: "config", summary: "설정 확인 (print | validate)", run: runConfigCommand},
+	{name: "create-admin", summary: "관리자 계정 생성 (비밀번호는 입력 프롬프트)", run: runCreateAdminCommand},
+	{name: "routes", summary: "등록된 라우트와 미들웨어 출력", run: runRoutesCommand},
+	{name: "healthcheck", summary: "실행 중인 서버 상태 확인 (Docker HEALTHCHECK용, 실패 시 exit 1)", run: runHealthcheckCommand},
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "사용법: server [command] [-env=local] [-config-dir=config] [-set key=value] [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-13s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "명령별 flag: server <command> -h")
+}
+
+// newFlagSet returns a flag set with the config flags every command shares
+func newFlagSet(name string) (*flag.FlagSet, *config.LoadOptions) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	opts := &config.LoadOptions{Overrides: config.Overrides{}}
+	registerConfigFlags(fs, opts)
+	return fs, opts
+}
+
+// registerConfigFlags registers the flags shared by every command that loads configuration
+func registerConfigFlags(fs *flag.FlagSet, opts *config.LoadOptions) {
+	fs.StringVar(&opts.Env, "env", "local", "Environment (local|dev|prod)")
+	fs.StringVar(&opts.ConfigDir, "config-dir", "", "Config file directory (default: $CONFIG_DIR or ./config)")
+	fs.Var(opts.Overrides, "set", "Override a config value, repeatable (e.g. -set app.port=9090)")
+}
+
+// loadConfig loads the configuration the same way for every command
+func loadConfig(opts config.LoadOptions) (*config.Config, error) {
+	cfg, err := config.LoadWithOptions(opts)
+	if err != nil {
+		return nil, fmt.Errorf("설정 로드 실패: %w", err)
+	}
+	return cfg, nil
+}
+
+// openDatabase connects to the database the same way for every command; the caller closes it
+func openDatabase(cfg *config.Config) (*database.DB, error) {
+	db, err := database.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("데이터베이스 연결 실패: %w", err)
+	}
+	return db, nil
+}
+
+// setupLogger configures logging for a command: the regular logger, or warnings only on stderr
+// for commands whose stdout is their output (config print, routes, ...)
+func setupLogger(env string, quiet bool) {
+	if quiet {
+		slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})))
+		return
+	}
+	logger.Setup(env)
+}
+
+// withDatabase loads the configuration, connects to the database and runs fn, closing the connection after
+func withDatabase(opts config.LoadOptions, quiet bool, fn func(cfg *config.Config, db *database.DB) error) error {
+	setupLogger(opts.Env, quiet)
+
+	cfg, err := loadConfig(opts)
+	if err != nil {
+		return err
+	}
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			slog.Warn("데이터베이스 종료 실패", "error", err)
+		}
+	}()
+
+	return fn(cfg, db)
+}
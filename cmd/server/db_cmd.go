@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+)
+
+// runMigrateCommand creates missing tables and columns, keeping the data.
+//
+// Usage:
+//
+//	server migrate [-reset] [-env=local]
+func runMigrateCommand(args []string) error {
+	fs, opts := newFlagSet("migrate")
+	reset := fs.Bool("reset", false, "Drop every table and create them again (refused in prod)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	return withDatabase(*opts, false, func(cfg *config.Config, db *database.DB) error {
+		if *reset {
+			return database.Reset(db.DB, cfg)
+		}
+
+		if err := database.AutoMigrate(db.DB); err != nil {
+			return err
+		}
+		slog.Info("✅ 마이그레이션 완료", "env", cfg.App.Env)
+		return nil
+	})
+}
+
+// runSeedCommand inserts the development data (see database.Seed).
+//
+// Usage:
+//
+//	server seed [-env=local]
+func runSeedCommand(args []string) error {
+	fs, opts := newFlagSet("seed")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	return withDatabase(*opts, false, func(cfg *config.Config, db *database.DB) error {
+		if err := database.Seed(context.Background(), db.DB, cfg); err != nil {
+			return err
+		}
+		slog.Info("✅ seed 완료", "env", cfg.App.Env, "demo_email", database.SeedMemberEmail)
+		return nil
+	})
+}
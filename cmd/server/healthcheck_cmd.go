@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+)
+
+// runHealthcheckCommand calls the probe of the server running on this host and fails unless it answers 200.
+// Only the configuration is loaded (port, TLS); the database is checked by the server's /readyz.
+//
+// Usage (Dockerfile):
+//
+//	HEALTHCHECK --interval=10s --timeout=5s CMD ["/app/server", "healthcheck", "-env=prod"]
+func runHealthcheckCommand(args []string) error {
+	fs, opts := newFlagSet("healthcheck")
+	path := fs.String("path", "/readyz", "Probe path (/livez, /readyz, /startupz)")
+	timeout := fs.Duration("timeout", 3*time.Second, "Request timeout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	setupLogger(opts.Env, true)
+	cfg, err := loadConfig(*opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	return checkHealth(ctx, healthcheckURL(cfg, *path), cfg.Server.TLSEnabled())
+}
+
+// healthcheckURL is the probe URL of the server on this host
+func healthcheckURL(cfg *config.Config, path string) string {
+	scheme := "http"
+	if cfg.Server.TLSEnabled() {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://127.0.0.1:%d%s", scheme, cfg.App.Port, path)
+}
+
+// checkHealth returns an error unless url answers 200
+func checkHealth(ctx context.Context, url string, insecureTLS bool) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	if insecureTLS {
+		// The certificate is issued for the public host name, not 127.0.0.1
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("헬스 체크 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("헬스 체크 실패: url=%s status=%d", url, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckHealth(t *testing.T) {
+	// Given: a server that is ready, and one that is draining
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	// When / Then
+	assert.NoError(t, checkHealth(context.Background(), srv.URL+"/readyz", false))
+
+	status = http.StatusServiceUnavailable
+	assert.ErrorContains(t, checkHealth(context.Background(), srv.URL+"/readyz", false), "status=503")
+}
+
+func TestCheckHealth_TLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	assert.NoError(t, checkHealth(context.Background(), srv.URL, true))
+}
+
+func TestHealthcheckURL(t *testing.T) {
+	cfg := testutil.NewTestConfig()
+	assert.Equal(t, "http://127.0.0.1:8080/readyz", healthcheckURL(cfg, "/readyz"))
+
+	cfg.Server.TLSCertFile, cfg.Server.TLSKeyFile = "cert.pem", "key.pem"
+	assert.Equal(t, "https://127.0.0.1:8080/livez", healthcheckURL(cfg, "/livez"))
+}
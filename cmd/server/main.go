@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -15,70 +17,292 @@ import (
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/router"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/job"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/lifecycle"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/outbox"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/validator"
 )
 
 func main() {
-	// Parse command line flags
-	env := parseFlags()
+	args := os.Args[1:]
+	name := "serve" // "server -env=dev" keeps starting the server
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
 
-	// Initialize logger
-	logger.Setup(env)
-	slog.Info("서버 초기화 시작", "env", env)
+	if name == "help" {
+		printUsage(os.Stdout)
+		return
+	}
+	cmd, ok := findCommand(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "알 수 없는 명령: %s\n\n", name)
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
 
-	// Run application
-	if err := run(env); err != nil {
-		slog.Error("서버 초기화 실패", "error", err)
+	if err := cmd.run(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		slog.Error("명령 실패", "command", cmd.name, "error", err)
 		os.Exit(1)
 	}
-
-	slog.Info("서버 종료 완료", "env", env)
 }
 
-// parseFlags parses command line arguments
-func parseFlags() string {
-	env := flag.String("env", "local", "Environment (local|dev|production)")
-	flag.Parse()
-	return *env
+// runServeCommand starts the HTTP server and the background workers until a shutdown signal
+func runServeCommand(args []string) error {
+	fs, opts := newFlagSet("serve")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Initialize logger
+	setupLogger(opts.Env, false)
+	slog.Info("서버 초기화 시작", "env", opts.Env)
+
+	// Run application
+	if err := run(*opts); err != nil {
+		return fmt.Errorf("서버 초기화 실패: %w", err)
+	}
+
+	slog.Info("서버 종료 완료", "env", opts.Env)
+	return nil
 }
 
-// run contains the main application logic
-func run(env string) error {
+// run contains the main application logic.
+// Components register their start/stop hooks on the lifecycle manager where they are created;
+// the phases decide the order (see package lifecycle).
+func run(opts config.LoadOptions) error {
 	// Create root context for application lifecycle
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	// Load configuration
-	cfg, err := config.Load(env)
+	cfg, err := loadConfig(opts)
 	if err != nil {
-		return fmt.Errorf("설정 로드 실패: %w", err)
+		return err
 	}
 
 	slog.Info("환경 변수 로드 성공")
 
+	lc := lifecycle.New(cfg.Shutdown.StepTimeout)
+	lc.Append(lifecycle.Hook{
+		Name:   "logger",
+		Phase:  lifecycle.PhaseLog,
+		OnStop: func(context.Context) error { return logger.Sync() },
+	})
+
+	// Runtime-tunable settings (CORS, log level, request timeout) follow config reloads
+	reloader := setupReloader(cfg, opts, lc)
+
 	// Connect to database
-	db, err := database.New(cfg)
+	db, err := openDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	lc.Append(lifecycle.Hook{
+		Name:   "database",
+		Phase:  lifecycle.PhaseResource,
+		OnStop: func(context.Context) error { return db.Close() },
+	})
+
+	// DB_AUTO_MIGRATE=true recreates the tables (local/dev); otherwise run the migrate command
+	if err := database.Migrate(db.DB, cfg); err != nil {
+		_ = lc.Stop(ctx)
+		return fmt.Errorf("마이그레이션 실패: %w", err)
+	}
+
+	// Setup background workers
+	setupOutbox(cfg, db, lc)
+	if err := setupJobs(cfg, db, lc); err != nil {
+		_ = lc.Stop(ctx)
+		return fmt.Errorf("Job 스케줄러 설정 실패: %w", err)
+	}
+
+	// Liveness/readiness/startup probes
+	probes := health.NewRegistry(cfg.Health)
+	router.RegisterHealthChecks(probes, cfg, db)
+
+	// Setup server
+	serverErrors, err := setupServer(cfg, reloader, db, probes, lc)
 	if err != nil {
-		return fmt.Errorf("데이터베이스 연결 실패: %w", err)
+		_ = lc.Stop(ctx)
+		return fmt.Errorf("라우터 설정 실패: %w", err)
+	}
+	setupReadiness(cfg, probes, lc)
+
+	if err := lc.Start(ctx); err != nil {
+		return err
 	}
-	defer func() {
-		if err := db.Close(); err != nil {
-			slog.Error("데이터베이스 종료 실패", "error", err)
+	return waitForShutdown(serverErrors, lc)
+}
+
+// waitForShutdown blocks until a shutdown signal or a server failure, then stops every component.
+// A second signal skips the remaining waits (pre-stop delay, draining).
+func waitForShutdown(serverErrors <-chan error, lc *lifecycle.Manager) error {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(quit)
+
+	var runErr error
+	select {
+	case err := <-serverErrors:
+		// Server failed to start or stopped unexpectedly
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			runErr = fmt.Errorf("서버 오류: %w", err)
+		}
+	case sig := <-quit:
+		slog.Info("종료 신호 수신됨", "signal", sig.String())
+	}
+
+	stopCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-quit:
+			slog.Warn("종료 신호 재수신 - 남은 대기를 생략합니다")
+			cancel()
+		case <-stopCtx.Done():
 		}
 	}()
 
-	// Setup server
-	srv := setupServer(cfg, db)
+	if err := lc.Stop(stopCtx); err != nil {
+		return errors.Join(runErr, fmt.Errorf("종료 중 오류: %w", err))
+	}
+	return runErr
+}
+
+// setupOutbox registers domain event handlers and creates the outbox dispatcher.
+// It stops after the workers and delivers the events they left behind.
+func setupOutbox(cfg *config.Config, db *database.DB, lc *lifecycle.Manager) {
+	registry := outbox.NewRegistry()
+	router.RegisterEventHandlers(registry)
+
+	dispatcher := outbox.NewDispatcher(db.DB, registry, cfg.Outbox)
+	lc.Append(lifecycle.Hook{
+		Name:  "outbox",
+		Phase: lifecycle.PhaseFlush,
+		OnStart: func(ctx context.Context) error {
+			dispatcher.Start(ctx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if err := dispatcher.Stop(ctx); err != nil {
+				return err
+			}
+			n, err := dispatcher.Flush(ctx)
+			slog.Info("Outbox 남은 이벤트 전달", "count", n)
+			return err
+		},
+		StopTimeout: cfg.Shutdown.FlushTimeout,
+	})
+}
+
+// setupJobs registers periodic jobs and delayed job handlers on a new scheduler
+func setupJobs(cfg *config.Config, db *database.DB, lc *lifecycle.Manager) error {
+	scheduler := job.NewScheduler(db.DB, cfg.Job)
+	if err := router.RegisterJobs(scheduler, cfg, db); err != nil {
+		return err
+	}
+
+	lc.Append(lifecycle.Hook{
+		Name:  "jobs",
+		Phase: lifecycle.PhaseWorker,
+		OnStart: func(ctx context.Context) error {
+			scheduler.Start(ctx)
+			return nil
+		},
+		OnStop:      scheduler.Stop,
+		StopTimeout: cfg.Shutdown.WorkerTimeout,
+	})
+	return nil
+}
+
+// setupReloader creates the runtime config reloader and applies the configured log level.
+// Config files are watched (polling) and reloaded on SIGHUP; file/provider backed secrets are re-read.
+func setupReloader(cfg *config.Config, opts config.LoadOptions, lc *lifecycle.Manager) *config.Reloader {
+	applyLogLevel := func(rc *config.RuntimeConfig) {
+		if err := logger.SetLevel(rc.LogLevel); err != nil {
+			slog.Error("로그 레벨 변경 실패", "error", err)
+		}
+	}
+
+	reloader := config.NewReloader(cfg, opts)
+	applyLogLevel(reloader.Current())
+	reloader.OnReload(applyLogLevel)
+
+	secretRefresher := config.NewSecretRefresher(cfg)
+	lc.Append(lifecycle.Hook{
+		Name:  "secret-refresher",
+		Phase: lifecycle.PhaseWorker,
+		OnStart: func(ctx context.Context) error {
+			secretRefresher.Start(ctx)
+			return nil
+		},
+		OnStop: secretRefresher.Stop,
+	})
+
+	watcher := config.NewWatcher(reloader, cfg.Reload.WatchInterval)
+	lc.Append(lifecycle.Hook{
+		Name:  "config-watcher",
+		Phase: lifecycle.PhaseWorker,
+		OnStart: func(ctx context.Context) error {
+			watcher.Start(ctx)
+			return nil
+		},
+		OnStop: watcher.Stop,
+	})
+
+	var stopReloadSignal func()
+	lc.Append(lifecycle.Hook{
+		Name:  "reload-signal",
+		Phase: lifecycle.PhaseWorker,
+		OnStart: func(context.Context) error {
+			stopReloadSignal = reloadOnSignal(reloader)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			stopReloadSignal()
+			return nil
+		},
+	})
+	return reloader
+}
+
+// reloadOnSignal reloads the runtime config on SIGHUP until the returned stop function is called
+func reloadOnSignal(reloader *config.Reloader) (stop func()) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-hup:
+				slog.Info("SIGHUP 수신 - 설정을 다시 읽습니다")
+				if _, err := reloader.Reload(); err != nil {
+					slog.Error("설정 재적용 실패", "error", err)
+				}
+			}
+		}
+	}()
 
-	// Start server with graceful shutdown
-	return startWithGracefulShutdown(ctx, srv, cfg.Server.GracefulTimeout)
+	return func() {
+		signal.Stop(hup)
+		close(done)
+	}
 }
 
-// setupServer initializes and configures the HTTP server
-func setupServer(cfg *config.Config, db *database.DB) *bootstrap.Server {
+// setupServer initializes and configures the HTTP server.
+// Errors of the running server (e.g. the port in use) are sent on the returned channel.
+func setupServer(cfg *config.Config, reloader *config.Reloader, db *database.DB, probes *health.Registry, lc *lifecycle.Manager) (<-chan error, error) {
 	// Bootstrap server with common setup
-	boot := bootstrap.NewBootstrap(cfg)
+	boot := bootstrap.NewBootstrap(cfg, reloader)
 	ginEngine := boot.SetupEngine()
 
 	// Register common validators
@@ -88,51 +312,65 @@ func setupServer(cfg *config.Config, db *database.DB) *bootstrap.Server {
 	}
 
 	// Setup application-specific routes
-	router.Setup(ginEngine, cfg, db)
+	if err := router.Setup(ginEngine, cfg, reloader, db, probes); err != nil {
+		return nil, err
+	}
 
 	slog.Info("서버 설정 완료",
 		"env", cfg.App.Env,
 	)
 
-	return bootstrap.New(cfg, ginEngine)
+	srv := bootstrap.New(cfg, ginEngine)
+	serverErrors := make(chan error, 1)
+	lc.Append(lifecycle.Hook{
+		Name:  "http",
+		Phase: lifecycle.PhaseServer,
+		OnStart: func(context.Context) error {
+			go func() {
+				serverErrors <- srv.Start()
+			}()
+			return nil
+		},
+		// Stops accepting connections and waits for in-flight requests
+		OnStop:      srv.Shutdown,
+		StopTimeout: cfg.Server.GracefulTimeout,
+	})
+	return serverErrors, nil
 }
 
-// startWithGracefulShutdown starts the server and handles graceful shutdown
-func startWithGracefulShutdown(ctx context.Context, srv *bootstrap.Server, gracefulTimeout time.Duration) error {
-	// Channel to receive server errors
-	serverErrors := make(chan error, 1)
+// setupReadiness makes readiness and startup run their checks once everything started.
+// On shutdown readiness fails first and HEALTH_DRAIN_DELAY passes so load balancers stop routing
+// to this instance before the HTTP server refuses connections.
+func setupReadiness(cfg *config.Config, probes *health.Registry, lc *lifecycle.Manager) {
+	delay := cfg.Health.DrainDelay
+	lc.Append(lifecycle.Hook{
+		Name:  "readiness",
+		Phase: lifecycle.PhaseReadiness,
+		OnStart: func(context.Context) error {
+			probes.MarkStarted()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			probes.StartDraining()
+			waitDrain(ctx, delay)
+			return nil
+		},
+		StopTimeout: delay + cfg.Shutdown.StepTimeout,
+	})
+}
 
-	// Start server in goroutine
-	go func() {
-		serverErrors <- srv.Start()
-	}()
+// waitDrain waits for the readiness drain delay, returning early when ctx is cancelled
+func waitDrain(ctx context.Context, delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
 
-	// Channel to receive OS signals
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	slog.Info("트래픽 drain 대기", "delay", delay.String())
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
 
-	// Wait for either server error or interrupt signal
 	select {
-	case err := <-serverErrors:
-		// Server failed to start or stopped unexpectedly
-		if err != nil && err != http.ErrServerClosed {
-			return fmt.Errorf("서버 오류: %w", err)
-		}
-		return nil
-
-	case sig := <-quit:
-		// Received shutdown signal
-		slog.Info("종료 신호 수신됨", "signal", sig.String())
-
-		// Create shutdown context with timeout
-		shutdownCtx, cancel := context.WithTimeout(ctx, gracefulTimeout)
-		defer cancel()
-
-		// Attempt graceful shutdown
-		slog.Info("서버 종료 중...")
-		if err := srv.Shutdown(shutdownCtx); err != nil {
-			return fmt.Errorf("서버 강제 종료: %w", err)
-		}
-		return nil
+	case <-timer.C:
+	case <-ctx.Done():
 	}
 }
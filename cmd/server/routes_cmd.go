@@ -0,0 +1,140 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/bootstrap"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/router"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/gin-gonic/gin"
+)
+
+// runRoutesCommand prints every route with its middleware chain.
+// The routes are built like serve builds them, but without connecting to the database
+// (nothing is queried while registering routes), so it also runs in CI.
+//
+// Usage:
+//
+//	server routes [-env=local]
+func runRoutesCommand(args []string) error {
+	fs, opts := newFlagSet("routes")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	setupLogger(opts.Env, true)
+	cfg, err := loadConfig(*opts)
+	if err != nil {
+		return err
+	}
+
+	reloader := config.NewReloader(cfg, *opts)
+	engine := bootstrap.NewBootstrap(cfg, reloader).SetupEngine()
+	recorder := recordChains(engine)
+	if err := router.Setup(engine, cfg, reloader, &database.DB{}, health.NewRegistry(cfg.Health)); err != nil {
+		return err
+	}
+
+	global, routes := recorder.routes(engine)
+	return printRoutes(os.Stdout, global, routes)
+}
+
+// routeChain is a registered route with the handlers it runs
+type routeChain struct {
+	Method     string
+	Path       string
+	Middleware []string
+	Handler    string
+}
+
+// chainRecorder learns the handler chain of each route. gin only exposes the last handler of a route,
+// so the recorder runs first in every chain, keeps c.HandlerNames() and aborts before the rest runs.
+type chainRecorder struct {
+	last []string
+}
+
+// recordChains puts a recorder in front of the engine's middleware.
+// Call it before registering routes: gin copies the middleware into each route when it is added.
+func recordChains(engine *gin.Engine) *chainRecorder {
+	r := &chainRecorder{}
+	engine.Handlers = append(gin.HandlersChain{r.record}, engine.Handlers...)
+	return r
+}
+
+func (r *chainRecorder) record(c *gin.Context) {
+	r.last = c.HandlerNames()[1:] // without the recorder itself
+	c.Abort()
+}
+
+// routes dispatches one request per route to read its chain. It returns the middleware every route
+// runs (engine.Use) and the routes sorted by path, each with the middleware of its group and its own.
+func (r *chainRecorder) routes(engine *gin.Engine) (global []string, routes []routeChain) {
+	globalCount := len(engine.Handlers) - 1 // without the recorder
+
+	for _, info := range engine.Routes() {
+		r.last = nil
+		engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(info.Method, samplePath(info.Path), nil))
+
+		route := routeChain{Method: info.Method, Path: info.Path, Handler: shortHandlerName(info.Handler)}
+		if n := len(r.last); n > globalCount {
+			if global == nil {
+				for _, name := range r.last[:globalCount] {
+					global = append(global, shortHandlerName(name))
+				}
+			}
+			for _, name := range r.last[globalCount : n-1] {
+				route.Middleware = append(route.Middleware, shortHandlerName(name))
+			}
+		}
+		routes = append(routes, route)
+	}
+
+	slices.SortStableFunc(routes, func(a, b routeChain) int {
+		return cmp.Or(strings.Compare(a.Path, b.Path), strings.Compare(a.Method, b.Method))
+	})
+	return global, routes
+}
+
+// samplePath fills the path parameters of a route pattern (/flags/:key -> /flags/_)
+func samplePath(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "_"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// closures are named Func.func1, or Func.1 when inlined; method values end in -fm
+var closureSuffix = regexp.MustCompile(`((\.func\d+)|(\.\d+))+$|-fm$`)
+
+// shortHandlerName turns a function name into package.Func
+// (github.com/.../middleware.JWT.func1 -> middleware.JWT)
+func shortHandlerName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return closureSuffix.ReplaceAllString(name, "")
+}
+
+func printRoutes(w io.Writer, global []string, routes []routeChain) error {
+	fmt.Fprintf(w, "Global middleware: %s\n\n", strings.Join(global, " > "))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATH\tHANDLER\tMIDDLEWARE")
+	for _, route := range routes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", route.Method, route.Path, route.Handler, strings.Join(route.Middleware, " > "))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requestID(c *gin.Context)    { c.Next() }
+func authRequired(c *gin.Context) { c.Next() }
+
+// lastPart drops the package, which is "main" or "server" depending on how the test is built
+func lastPart(names []string) []string {
+	out := make([]string, len(names))
+	for i, name := range names {
+		out[i] = name[strings.LastIndex(name, ".")+1:]
+	}
+	return out
+}
+
+func TestChainRecorder_RecordsMiddlewareWithoutRunningHandlers(t *testing.T) {
+	// Given
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(requestID)
+	recorder := recordChains(engine)
+
+	called := false
+	members := engine.Group("/members", authRequired)
+	members.GET("/:id", func(c *gin.Context) { called = true })
+	engine.GET("/livez", func(c *gin.Context) { called = true })
+
+	// When
+	global, routes := recorder.routes(engine)
+
+	// Then
+	assert.False(t, called, "handlers must not run")
+	assert.Equal(t, []string{"requestID"}, lastPart(global))
+	require.Len(t, routes, 2)
+	assert.Equal(t, "/livez", routes[0].Path)
+	assert.Empty(t, routes[0].Middleware)
+	assert.Equal(t, "/members/:id", routes[1].Path)
+	assert.Equal(t, []string{"authRequired"}, lastPart(routes[1].Middleware))
+
+	var out bytes.Buffer
+	require.NoError(t, printRoutes(&out, global, routes))
+	assert.Contains(t, out.String(), "Global middleware: ")
+	assert.Contains(t, out.String(), "/members/:id")
+}
+
+func TestShortHandlerName(t *testing.T) {
+	tests := map[string]string{
+		"github.com/x/api/internal/shared/middleware.JWT.func1":   "middleware.JWT",
+		"github.com/x/api/internal/shared/handler.Handle.1":       "handler.Handle",
+		"github.com/x/api/internal/meta.(*Handler).Livez-fm":      "meta.(*Handler).Livez",
+		"github.com/x/api/internal/legal.ConsentCheck.func1.1":    "legal.ConsentCheck",
+		"github.com/gin-gonic/gin.CustomRecoveryWithWriter.func1": "gin.CustomRecoveryWithWriter",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, shortHandlerName(name), name)
+	}
+}
@@ -3,19 +3,33 @@ package auth_test
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/auth"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/legal"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/member"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
 	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/i18n"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 // setupTestEnvironment creates all dependencies needed for auth handler tests
 func setupTestEnvironment(t *testing.T) (*auth.AuthHandler, *testutil.MockTokenManager) {
 	t.Helper()
 
+	authHandler, mockTokenManager, _ := setupTestEnvironmentWithDB(t)
+	return authHandler, mockTokenManager
+}
+
+// setupTestEnvironmentWithDB also returns the test database for tests that seed data
+func setupTestEnvironmentWithDB(t *testing.T) (*auth.AuthHandler, *testutil.MockTokenManager, *gorm.DB) {
+	t.Helper()
+
 	// Setup test database
 	db := testutil.SetupTestDB(t)
 	t.Cleanup(func() {
@@ -24,11 +38,12 @@ func setupTestEnvironment(t *testing.T) (*auth.AuthHandler, *testutil.MockTokenM
 
 	// Setup dependencies
 	memberRepo := member.NewMemberRepository()
+	legalService := legal.NewLegalService(db, legal.NewLegalRepository(), 0)
 	mockTokenManager := testutil.NewMockTokenManager()
-	authService := auth.NewAuthService(db, memberRepo, mockTokenManager)
+	authService := auth.NewAuthService(db, memberRepo, legalService, mockTokenManager)
 	authHandler := auth.NewAuthHandler(authService)
 
-	return authHandler, mockTokenManager
+	return authHandler, mockTokenManager, db
 }
 
 func TestSignup_Success(t *testing.T) {
@@ -36,7 +51,7 @@ func TestSignup_Success(t *testing.T) {
 	authHandler, _ := setupTestEnvironment(t)
 
 	router := testutil.SetupTestRouter()
-	router.POST("/api/v1/auth/signup", authHandler.Signup)
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
 
 	// Given: Valid signup request
 	request := testutil.TestRequest{
@@ -62,7 +77,7 @@ func TestSignup_DuplicateEmail(t *testing.T) {
 	authHandler, _ := setupTestEnvironment(t)
 
 	router := testutil.SetupTestRouter()
-	router.POST("/api/v1/auth/signup", authHandler.Signup)
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
 
 	// Given: Create first user
 	firstRequest := testutil.TestRequest{
@@ -108,7 +123,7 @@ func TestSignup_ValidationError_MissingRequiredFields(t *testing.T) {
 	authHandler, _ := setupTestEnvironment(t)
 
 	router := testutil.SetupTestRouter()
-	router.POST("/api/v1/auth/signup", authHandler.Signup)
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
 
 	testCases := []struct {
 		name        string
@@ -181,7 +196,7 @@ func TestSignup_ValidationError_InvalidEmail(t *testing.T) {
 	authHandler, _ := setupTestEnvironment(t)
 
 	router := testutil.SetupTestRouter()
-	router.POST("/api/v1/auth/signup", authHandler.Signup)
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
 
 	// Given: Request with invalid email format
 	request := testutil.TestRequest{
@@ -211,7 +226,7 @@ func TestSignup_ValidationError_PasswordTooShort(t *testing.T) {
 	authHandler, _ := setupTestEnvironment(t)
 
 	router := testutil.SetupTestRouter()
-	router.POST("/api/v1/auth/signup", authHandler.Signup)
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
 
 	// Given: Request with short password
 	request := testutil.TestRequest{
@@ -235,3 +250,140 @@ func TestSignup_ValidationError_PasswordTooShort(t *testing.T) {
 	testutil.ParseResponse(t, recorder, &errorResponse)
 	assert.NotEmpty(t, errorResponse.Message)
 }
+
+func TestSignup_ValidationError_ProblemJSON(t *testing.T) {
+	// Given: Setup test environment
+	authHandler, _ := setupTestEnvironment(t)
+
+	router := testutil.SetupTestRouter()
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
+
+	// Given: Request with several invalid fields, asking for problem+json
+	request := testutil.TestRequest{
+		Method: http.MethodPost,
+		URL:    "/api/v1/auth/signup",
+		Body: auth.SignupRequest{
+			Name:        "Test User",
+			Email:       "invalid-email-format",
+			PhoneNumber: "010-1234-5678",
+			Password:    "short",
+		},
+		Headers: map[string]string{"Accept": "application/problem+json"},
+	}
+
+	// When: Execute request
+	recorder := testutil.ExecuteRequest(t, router, request)
+
+	// Then: Every failed field is listed
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, sharedError.ContentTypeProblem, recorder.Header().Get("Content-Type"))
+
+	var problem sharedError.Problem
+	testutil.ParseResponse(t, recorder, &problem)
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, sharedError.ValidationFailed.Code, problem.Code)
+
+	// Title is the generic validation message, the first field message goes to Detail
+	assert.Equal(t, i18n.T(i18n.DefaultLocale, sharedError.ValidationFailed.MessageKey), problem.Title)
+	assert.Equal(t, problem.Errors[0].Message, problem.Detail)
+
+	require.Len(t, problem.Errors, 2)
+	assert.Equal(t, "email", problem.Errors[0].Tag)
+	assert.Equal(t, "min", problem.Errors[1].Tag)
+	assert.Equal(t, "8", problem.Errors[1].Param)
+	assert.NotEmpty(t, problem.Errors[1].Message)
+}
+
+func TestSignup_ValidationError_EnglishMessage(t *testing.T) {
+	// Given: Setup test environment
+	authHandler, _ := setupTestEnvironment(t)
+
+	router := testutil.SetupTestRouter()
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
+
+	// Given: Request with short password in English
+	request := testutil.TestRequest{
+		Method: http.MethodPost,
+		URL:    "/api/v1/auth/signup",
+		Body: auth.SignupRequest{
+			Name:        "Test User",
+			Email:       "test@example.com",
+			PhoneNumber: "010-1234-5678",
+			Password:    "short",
+		},
+		Headers: map[string]string{"Accept-Language": "en-US,en;q=0.9"},
+	}
+
+	// When: Execute request
+	recorder := testutil.ExecuteRequest(t, router, request)
+
+	// Then: Message uses the English template and the JSON field name
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "en", recorder.Header().Get("Content-Language"))
+
+	var errorResponse sharedError.ErrorResponse
+	testutil.ParseResponse(t, recorder, &errorResponse)
+	assert.Equal(t, "password must be at least 8 characters.", errorResponse.Message)
+}
+
+func TestSignup_LegalConsents(t *testing.T) {
+	// Given: Terms of service is in effect
+	authHandler, _, db := setupTestEnvironmentWithDB(t)
+	require.NoError(t, db.Create(&model.LegalDocument{
+		Type:        model.LegalTermsOfService,
+		Version:     "2025-01",
+		Title:       "서비스 이용약관",
+		Content:     "# 서비스 이용약관",
+		Required:    true,
+		EffectiveAt: time.Now().Add(-time.Hour),
+	}).Error)
+
+	router := testutil.SetupTestRouter()
+	router.POST("/api/v1/auth/signup", handler.Handle(authHandler.Signup))
+
+	signup := func(email string, consents []legal.ConsentItem) testutil.TestRequest {
+		return testutil.TestRequest{
+			Method: http.MethodPost,
+			URL:    "/api/v1/auth/signup",
+			Body: auth.SignupRequest{
+				Name:        "Test User",
+				Email:       email,
+				PhoneNumber: "010-1234-5678",
+				Password:    "password123",
+				Consents:    consents,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		consents     []legal.ConsentItem
+		expectedCode string
+	}{
+		{name: "Missing consent", consents: nil, expectedCode: "LEGAL-002"},
+		{name: "Outdated version", consents: []legal.ConsentItem{{Type: model.LegalTermsOfService, Version: "2024-01"}}, expectedCode: "LEGAL-003"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, router, signup("rejected@example.com", tc.consents))
+
+			// Then: Member is not created
+			var errorResponse sharedError.ErrorResponse
+			testutil.ParseResponse(t, recorder, &errorResponse)
+			assert.Equal(t, tc.expectedCode, errorResponse.Code)
+		})
+	}
+
+	// When: Accepting the current version (same email, the rejected signups were rolled back)
+	recorder := testutil.ExecuteRequest(t, router, signup("rejected@example.com",
+		[]legal.ConsentItem{{Type: model.LegalTermsOfService, Version: "2025-01"}}))
+
+	// Then
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	var count int64
+	require.NoError(t, db.Model(&model.MemberConsent{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
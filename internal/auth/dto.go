@@ -1,10 +1,13 @@
 package auth
 
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/legal"
+
 type SignupRequest struct {
-	Name        string `json:"name" binding:"required,min=1,max=20"`
-	Email       string `json:"email" binding:"required,email,max=50"`
-	PhoneNumber string `json:"phoneNumber" binding:"required,phone"`
-	Password    string `json:"password" binding:"required,min=8,max=15"`
+	Name        string              `json:"name" binding:"required,min=1,max=20"`
+	Email       string              `json:"email" binding:"required,email,max=50"`
+	PhoneNumber string              `json:"phoneNumber" binding:"required,phone"`
+	Password    string              `json:"password" binding:"required,min=8,max=15"`
+	Consents    []legal.ConsentItem `json:"consents" binding:"omitempty,dive"` // 동의한 약관 버전 (시행 중인 필수 약관은 모두 포함해야 함)
 }
 
 type LoginRequest struct {
@@ -15,8 +15,8 @@ var (
 
 func init() {
 	sharedError.RegisterDomainErrorResponse(incorrectEmailPassword, sharedError.ErrorResponse{
-		Status:  http.StatusBadRequest,
-		Code:    "AUTH-003",
-		Message: "이메일 또는 비밀번호가 일치하지 않습니다.",
+		Status:     http.StatusBadRequest,
+		Code:       "AUTH-003",
+		MessageKey: "auth.incorrect_email_password",
 	})
 }
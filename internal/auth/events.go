@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/member"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/outbox"
+)
+
+// RegisterEventHandlers subscribes auth side effects to domain events
+func RegisterEventHandlers(registry *outbox.Registry) {
+	outbox.Subscribe(registry, "auth.welcome", sendWelcome)
+}
+
+// sendWelcome handles the welcome notification after signup
+func sendWelcome(ctx context.Context, event member.MemberSignedUp) error {
+	// TODO: 메일 발송 연동 전까지 로그로 대체
+	logger.FromContext(ctx).Info("가입 환영 메시지 발송",
+		"member_id", event.MemberID,
+		"email", logger.MaskEmail(event.Email),
+	)
+	return nil
+}
@@ -1,7 +1,6 @@
 package auth
 
 import (
-	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
 	"github.com/gin-gonic/gin"
 )
@@ -16,45 +15,27 @@ func NewAuthHandler(authService *AuthService) *AuthHandler {
 	}
 }
 
-func (a *AuthHandler) Login(c *gin.Context) {
+func (a *AuthHandler) Login(c *gin.Context) (any, error) {
 	var request LoginRequest
 
 	// Parse and validate JSON request
-	if !handler.BindJSON(c, &request) {
-		return
+	if err := handler.ShouldBindJSON(c, &request); err != nil {
+		return nil, err
 	}
 
-	response, err := a.authService.Login(c.Request.Context(), &request)
-	if err != nil {
-		if resp, ok := sharedError.ResolveDomainError(err); ok {
-			handler.RespondError(c, err, resp)
-			return
-		}
-
-		handler.RespondError(c, err, sharedError.InternalServerError)
-		return
-	}
-
-	c.JSON(200, response)
+	return a.authService.Login(c.Request.Context(), &request)
 }
 
-func (a *AuthHandler) Signup(c *gin.Context) {
+func (a *AuthHandler) Signup(c *gin.Context) (any, error) {
 	var request SignupRequest
 
 	// Parse and validate JSON request
-	if !handler.BindJSON(c, &request) {
-		return
+	if err := handler.ShouldBindJSON(c, &request); err != nil {
+		return nil, err
 	}
 
-	err := a.authService.Signup(c.Request.Context(), &request)
-	if err != nil {
-		if resp, ok := sharedError.ResolveDomainError(err); ok {
-			handler.RespondError(c, err, resp)
-			return
-		}
-
-		handler.RespondError(c, err, sharedError.InternalServerError)
-		return
+	if err := a.authService.Signup(c.Request.Context(), &request); err != nil {
+		return nil, err
 	}
-	c.JSON(201, gin.H{})
+	return handler.Created(gin.H{}), nil
 }
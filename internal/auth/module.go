@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/route"
+)
+
+// Module provides signup and login. It needs the member and legal modules.
+var Module = di.Module{
+	Name:      "auth",
+	Providers: []any{NewAuthService, NewAuthHandler},
+	Routes: func(api *route.API, h *AuthHandler) {
+		api.Auth.POST("/signup", api.Idempotent, handler.Handle(h.Signup))
+		api.Auth.POST("/login", handler.Handle(h.Login))
+	},
+}
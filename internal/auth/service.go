@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/legal"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/member"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/outbox"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/token"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
@@ -17,13 +19,15 @@ import (
 type AuthService struct {
 	db               *gorm.DB
 	memberRepository *member.MemberRepository
+	legalService     *legal.LegalService
 	tokenManager     token.Manager
 }
 
-func NewAuthService(db *gorm.DB, memberRepository *member.MemberRepository, tokenManager token.Manager) *AuthService {
+func NewAuthService(db *gorm.DB, memberRepository *member.MemberRepository, legalService *legal.LegalService, tokenManager token.Manager) *AuthService {
 	return &AuthService{
 		db:               db,
 		memberRepository: memberRepository,
+		legalService:     legalService,
 		tokenManager:     tokenManager,
 	}
 }
@@ -49,12 +53,12 @@ func (a *AuthService) Login(ctx context.Context, request *LoginRequest) (*LoginR
 	memberID := strconv.FormatUint(uint64(member.ID), 10)
 	accessToken, err := a.tokenManager.GenerateAccessToken(memberID, member.Email)
 	if err != nil {
-		return nil, fmt.Errorf("AccessToken 생성 실패: memberID=%d %w", memberID, err)
+		return nil, fmt.Errorf("AccessToken 생성 실패: memberID=%s %w", memberID, err)
 	}
 
 	refreshToken, err := a.tokenManager.GenerateRefreshToken(memberID, member.Email)
 	if err != nil {
-		return nil, fmt.Errorf("RefreshToken 생성 실패: memberID=%d %w", memberID, err)
+		return nil, fmt.Errorf("RefreshToken 생성 실패: memberID=%s %w", memberID, err)
 	}
 
 	log.Info("로그인 성공", "email", logger.MaskEmail(request.Email))
@@ -81,11 +85,25 @@ func (a *AuthService) Signup(ctx context.Context, request *SignupRequest) error
 			return fmt.Errorf("비밀번호 해싱 실패: %w", err)
 		}
 
-		member := model.NewMember(request.Name, request.Email, request.PhoneNumber, string(hashedPassword))
-		if err := a.memberRepository.Create(ctx, tx, member); err != nil {
+		newMember := model.NewMember(request.Name, request.Email, request.PhoneNumber, string(hashedPassword))
+		if err := a.memberRepository.Create(ctx, tx, newMember); err != nil {
 			return fmt.Errorf("회원 계정 생성 실패: %w", err)
 		}
 
+		if err := a.legalService.RecordConsents(ctx, tx, newMember.ID, request.Consents, true); err != nil {
+			return fmt.Errorf("회원 가입 약관 동의 처리 실패: email=%s %w", logger.MaskEmail(request.Email), err)
+		}
+
+		signedUp := member.MemberSignedUp{
+			MemberID:   newMember.ID,
+			Name:       newMember.Name,
+			Email:      newMember.Email,
+			OccurredAt: newMember.CreatedAt,
+		}
+		if err := outbox.Publish(ctx, tx, signedUp); err != nil {
+			return fmt.Errorf("회원 가입 이벤트 발행 실패: %w", err)
+		}
+
 		log.Info("Member created successfully", "email", logger.MaskEmail(request.Email))
 		return nil
 	})
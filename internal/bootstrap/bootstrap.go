@@ -1,26 +1,37 @@
 package bootstrap
 
 import (
+	"fmt"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"io"
 	"log/slog"
-	"net/http"
 )
 
 // Bootstrap handles common server setup that can be reused across projects
 type Bootstrap struct {
-	cfg *config.Config
+	cfg           *config.Config
+	reloader      *config.Reloader
+	crashReporter middleware.CrashReporter // optional
 }
 
-// NewBootstrap creates a new bootstrap instance
-func NewBootstrap(cfg *config.Config) *Bootstrap {
+// NewBootstrap creates a new bootstrap instance.
+// Middleware that supports hot reload reads its settings from reloader.
+func NewBootstrap(cfg *config.Config, reloader *config.Reloader) *Bootstrap {
 	return &Bootstrap{
-		cfg: cfg,
+		cfg:      cfg,
+		reloader: reloader,
 	}
 }
 
+// SetCrashReporter forwards recovered panics to reporter (call before SetupEngine)
+func (b *Bootstrap) SetCrashReporter(reporter middleware.CrashReporter) {
+	b.crashReporter = reporter
+}
+
 // SetupEngine creates and configures a gin engine with common middleware
 // This is reusable across different projects
 func (b *Bootstrap) SetupEngine() *gin.Engine {
@@ -35,15 +46,44 @@ func (b *Bootstrap) SetupEngine() *gin.Engine {
 	gin.DefaultWriter = io.Discard
 	gin.DefaultErrorWriter = io.Discard
 
+	// Error response format (legacy JSON or RFC 7807 problem+json)
+	sharedError.SetProblemOptions(sharedError.ProblemOptions{
+		Default:  b.cfg.Error.Format == "problem",
+		TypeBase: b.cfg.Error.ProblemTypeBase,
+	})
+
+	// Strict mode rejects JSON fields the request DTO does not declare
+	binding.EnableDecoderDisallowUnknownFields = b.cfg.Body.StrictJSON
+
+	// Startup report of every domain error code (duplicates already panicked at registration)
+	logDomainErrors()
+
 	// Create engine without default middleware
 	engine := gin.New()
 
+	// c.ClientIP() trusts forwarding headers only from configured proxies
+	configureProxies(engine, b.cfg.Proxy)
+
 	// Essential middleware (common for all projects)
-	engine.Use(gin.CustomRecovery(b.recoveryHandler))
+	// Recovery runs inside RequestID/Logger so panics are logged with the request ID and still get an access log line
 	engine.Use(middleware.RequestID())
-	engine.Use(middleware.CORS(b.cfg))
-	engine.Use(middleware.Timeout(middleware.DefaultTimeout)) // 30 second global timeout
 	engine.Use(middleware.LoggerMiddleware())
+	if b.cfg.Security.Headers {
+		engine.Use(middleware.SecurityHeaders(b.cfg.Security, b.cfg.Proxy.TrustedProxies)) // before Recovery so error responses carry them too
+	}
+	engine.Use(middleware.Recovery(b.crashReporter))
+	engine.Use(middleware.CORS(b.reloader))
+	engine.Use(middleware.Timeout(b.reloader))     // SERVER_REQUEST_TIMEOUT global timeout
+	engine.Use(middleware.ErrorHandler())          // renders errors returned by handler.Handle handlers
+	engine.Use(middleware.RequestBody(b.cfg.Body)) // body size limit, gzip request decompression
+
+	// Response compression wraps ETag so tags are computed on the uncompressed body
+	if b.cfg.Response.Compression {
+		engine.Use(middleware.Compress(b.cfg.Response.CompressMinSize))
+	}
+	if b.cfg.Response.ETag {
+		engine.Use(middleware.ETag())
+	}
 
 	// Note: Health endpoints are now handled in routes.go following Clean Architecture
 	// This keeps the bootstrap focused on middleware setup only
@@ -51,17 +91,33 @@ func (b *Bootstrap) SetupEngine() *gin.Engine {
 	return engine
 }
 
-// recoveryHandler handles panics
-func (b *Bootstrap) recoveryHandler(c *gin.Context, recovered interface{}) {
-	if err, ok := recovered.(string); ok {
-		slog.Error("Panic Recovered",
-			"error", err,
-			"path", c.Request.URL.Path,
-			"method", c.Request.Method,
-			"request_id", middleware.GetRequestID(c),
-		)
+// configureProxies sets the proxies and headers c.ClientIP() trusts.
+// Without trusted proxies the connection address is used and X-Forwarded-For is ignored.
+func configureProxies(engine *gin.Engine, cfg config.ProxyConfig) {
+	headers := cfg.RemoteIPHeaders
+	if platform := cfg.PlatformIPHeader(); platform != "" {
+		// Checked first, and like the others only for requests from a trusted proxy
+		headers = append([]string{platform}, headers...)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-		"error": "Internal server error", "request_id": middleware.GetRequestID(c),
-	})
+	engine.RemoteIPHeaders = headers
+
+	if err := engine.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		// Validate already rejects invalid entries; never fall back to trusting everyone
+		slog.Error("신뢰 프록시 설정 실패 - 프록시 헤더를 무시합니다", "error", err)
+		_ = engine.SetTrustedProxies(nil)
+		return
+	}
+
+	slog.Info("신뢰 프록시 설정", "trusted_proxies", cfg.TrustedProxies, "remote_ip_headers", headers)
+}
+
+// logDomainErrors logs the registered domain error codes
+func logDomainErrors() {
+	entries := sharedError.RegisteredErrors()
+
+	codes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		codes = append(codes, fmt.Sprintf("%s(%d %s)", entry.Response.Code, entry.Response.Status, entry.ErrInfo))
+	}
+	slog.Info("도메인 에러 코드 등록 현황", "count", len(entries), "codes", codes)
 }
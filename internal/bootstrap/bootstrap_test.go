@@ -0,0 +1,101 @@
+package bootstrap_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/bootstrap"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClientIPEngine(proxy config.ProxyConfig) *gin.Engine {
+	cfg := testutil.NewTestConfig()
+	cfg.Proxy = proxy
+
+	engine := bootstrap.NewBootstrap(cfg, config.NewReloader(cfg, config.LoadOptions{})).SetupEngine()
+	engine.GET("/ip", func(c *gin.Context) { c.String(http.StatusOK, c.ClientIP()) })
+	return engine
+}
+
+func clientIP(engine *gin.Engine, remoteAddr string, headers map[string]string) string {
+	req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+	req.RemoteAddr = remoteAddr
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, req)
+	return recorder.Body.String()
+}
+
+func TestSetupEngine_ClientIP(t *testing.T) {
+	defaultHeaders := []string{"X-Forwarded-For", "X-Real-IP"}
+	tests := []struct {
+		name       string
+		proxy      config.ProxyConfig
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{
+			name:       "no trusted proxies: spoofed X-Forwarded-For ignored",
+			proxy:      config.ProxyConfig{RemoteIPHeaders: defaultHeaders},
+			remoteAddr: "203.0.113.7:5000",
+			headers:    map[string]string{"X-Forwarded-For": "1.2.3.4"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "request from trusted proxy",
+			proxy:      config.ProxyConfig{TrustedProxies: []string{"10.0.0.0/8"}, RemoteIPHeaders: defaultHeaders},
+			remoteAddr: "10.0.0.2:5000",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.9"},
+			want:       "198.51.100.9",
+		},
+		{
+			name:       "client-supplied hop before trusted proxy is not trusted",
+			proxy:      config.ProxyConfig{TrustedProxies: []string{"10.0.0.0/8"}, RemoteIPHeaders: defaultHeaders},
+			remoteAddr: "10.0.0.2:5000",
+			headers:    map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.9"},
+			want:       "198.51.100.9",
+		},
+		{
+			name:       "request bypassing the proxy: spoofed header ignored",
+			proxy:      config.ProxyConfig{TrustedProxies: []string{"10.0.0.0/8"}, RemoteIPHeaders: defaultHeaders},
+			remoteAddr: "203.0.113.7:5000",
+			headers:    map[string]string{"X-Forwarded-For": "1.2.3.4"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "platform header from trusted proxy",
+			proxy:      config.ProxyConfig{TrustedProxies: []string{"173.245.48.0/20"}, TrustedPlatform: "cloudflare", RemoteIPHeaders: defaultHeaders},
+			remoteAddr: "173.245.48.1:5000",
+			headers:    map[string]string{"CF-Connecting-IP": "198.51.100.9", "X-Forwarded-For": "1.2.3.4"},
+			want:       "198.51.100.9",
+		},
+		{
+			name:       "platform header from untrusted address ignored",
+			proxy:      config.ProxyConfig{TrustedProxies: []string{"173.245.48.0/20"}, TrustedPlatform: "cloudflare", RemoteIPHeaders: defaultHeaders},
+			remoteAddr: "203.0.113.7:5000",
+			headers:    map[string]string{"CF-Connecting-IP": "1.2.3.4"},
+			want:       "203.0.113.7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			engine := newClientIPEngine(tt.proxy)
+
+			// When
+			ip := clientIP(engine, tt.remoteAddr, tt.headers)
+
+			// Then
+			assert.Equal(t, tt.want, ip)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package bootstrap
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
 	"log/slog"
@@ -10,14 +12,26 @@ import (
 
 // Server represents the HTTP server (lifecycle management only)
 type Server struct {
-	cfg    *config.Config
-	server *http.Server
+	cfg      *config.Config
+	server   *http.Server
+	redirect *http.Server // HTTP -> HTTPS redirect listener (nil if disabled)
 }
 
 // New creates a new server instance with the provided handler
 func New(cfg *config.Config, handler http.Handler) *Server {
+	var redirect *http.Server
+	if cfg.Server.TLSEnabled() && cfg.Server.HTTPRedirectPort != 0 {
+		redirect = &http.Server{
+			Addr:              fmt.Sprintf(":%d", cfg.Server.HTTPRedirectPort),
+			Handler:           httpsRedirectHandler(cfg.App.Port),
+			ReadHeaderTimeout: cfg.Server.ReadTimeout,
+			IdleTimeout:       cfg.Server.IdleTimeout,
+		}
+	}
+
 	return &Server{
-		cfg: cfg,
+		cfg:      cfg,
+		redirect: redirect,
 		server: &http.Server{
 			Addr:           fmt.Sprintf(":%d", cfg.App.Port),
 			Handler:        handler,
@@ -34,16 +48,40 @@ func (s *Server) Port() int {
 	return s.cfg.App.Port
 }
 
-// Start starts the HTTP server
+// Start starts the HTTP server, or the HTTPS server (and optional redirect listener) when TLS is configured
 func (s *Server) Start() error {
 	slog.Info("서버 시작 중",
 		"port", s.cfg.App.Port,
 		"env", s.cfg.App.Env,
+		"tls", s.cfg.Server.TLSEnabled(),
 		"read_timeout", s.cfg.Server.ReadTimeout,
 		"write_timeout", s.cfg.Server.WriteTimeout,
 	)
 
-	return s.server.ListenAndServe()
+	if !s.cfg.Server.TLSEnabled() {
+		return s.server.ListenAndServe()
+	}
+
+	certs, err := newCertReloader(s.cfg.Server.TLSCertFile, s.cfg.Server.TLSKeyFile, s.cfg.Server.TLSReloadInterval)
+	if err != nil {
+		return err
+	}
+	s.server.TLSConfig = &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: certs.GetCertificate,
+	}
+
+	if s.redirect != nil {
+		go func() {
+			slog.Info("HTTP → HTTPS redirect 시작", "addr", s.redirect.Addr)
+			if err := s.redirect.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("HTTP redirect 서버 오류", "addr", s.redirect.Addr, "error", err)
+			}
+		}()
+	}
+
+	// Certificates come from TLSConfig.GetCertificate
+	return s.server.ListenAndServeTLS("", "")
 }
 
 // Shutdown gracefully shuts down the server
@@ -52,6 +90,12 @@ func (s *Server) Shutdown(ctx context.Context) error {
 		return nil
 	}
 
+	if s.redirect != nil {
+		if err := s.redirect.Shutdown(ctx); err != nil {
+			slog.Error("HTTP redirect 서버 종료 실패", "error", err)
+		}
+	}
+
 	err := s.server.Shutdown(ctx)
 	return err
 }
@@ -2,130 +2,268 @@ package config
 
 import (
 	"fmt"
-	"log/slog"
-	"os"
-	"path/filepath"
-	"strconv"
+	"net"
+	"slices"
 	"strings"
 	"time"
 
-	"github.com/joho/godotenv"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/appversion"
 )
 
+// Config is the application configuration.
+//
+// Each leaf field declares its file key (yaml/toml path), environment variable and default:
+//
+//	Port int `yaml:"port" env:"APP_PORT" default:"8080"`
+//
+// Fields tagged secret:"true" are masked by Dump(true) and may hold a secret reference
+// such as file:///run/secrets/db_password or env://OTHER_VAR (see SecretProvider).
 type Config struct {
-	App      AppConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	CORS     CORSConfig
-	Server   ServerConfig
+	App         AppConfig         `yaml:"app"`
+	Database    DatabaseConfig    `yaml:"database"`
+	JWT         JWTConfig         `yaml:"jwt"`
+	CORS        CORSConfig        `yaml:"cors"`
+	Server      ServerConfig      `yaml:"server"`
+	Outbox      OutboxConfig      `yaml:"outbox"`
+	Job         JobConfig         `yaml:"job"`
+	Secrets     SecretsConfig     `yaml:"secrets"`
+	Log         LogConfig         `yaml:"log"`
+	Reload      ReloadConfig      `yaml:"reload"`
+	Error       ErrorConfig       `yaml:"error"`
+	Body        BodyConfig        `yaml:"body"`
+	Response    ResponseConfig    `yaml:"response"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	Security    SecurityConfig    `yaml:"security"`
+	Proxy       ProxyConfig       `yaml:"proxy"`
+	Health      HealthConfig      `yaml:"health"`
+	Internal    InternalConfig    `yaml:"internal"`
+	Client      ClientConfig      `yaml:"client"`
+	Legal       LegalConfig       `yaml:"legal"`
+	Feature     FeatureConfig     `yaml:"feature"`
+	Shutdown    ShutdownConfig    `yaml:"shutdown"`
+
+	secretRefs *secretStore // secret references resolved at load time (see OnSecretChange)
 }
 
 type AppConfig struct {
-	Name string
-	Env  string
-	Port int
+	Name string `yaml:"name" env:"APP_NAME" default:"pray-together-api"`
+	Env  string `yaml:"-"` // -env 플래그로 결정
+	Port int    `yaml:"port" env:"APP_PORT" default:"8080"`
 }
 
 type DatabaseConfig struct {
-	Host            string
-	Port            int
-	Service         string
-	User            string
-	Password        string
-	MaxIdleConns    int
-	MaxOpenConns    int
-	ConnMaxLifetime time.Duration
-	ConnMaxIdleTime time.Duration
-	IsAutoMigrate   bool // true: 테이블 재생성, false: 마이그레이션 비활성화
+	Host            string        `yaml:"host" env:"DB_HOST"`
+	Port            int           `yaml:"port" env:"DB_PORT" default:"1521"`
+	Service         string        `yaml:"service" env:"DB_SERVICE"`
+	User            string        `yaml:"user" env:"DB_USER"`
+	Password        string        `yaml:"password" env:"DB_PASSWORD" secret:"true"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" default:"10"`
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS" default:"100"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" default:"1h"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME" default:"10m"`
+	IsAutoMigrate   bool          `yaml:"auto_migrate" env:"DB_AUTO_MIGRATE" default:"false"` // true: 테이블 재생성, false: 마이그레이션 비활성화 (기본값: 안전)
+
+	// Transaction retry policy (deadlock, serialization, dropped connection)
+	TxMaxAttempts    int           `yaml:"tx_max_attempts" env:"DB_TX_MAX_ATTEMPTS" default:"3"` // 1 이하: 재시도 비활성화
+	TxRetryBaseDelay time.Duration `yaml:"tx_retry_base_delay" env:"DB_TX_RETRY_BASE_DELAY" default:"50ms"`
+	TxRetryMaxDelay  time.Duration `yaml:"tx_retry_max_delay" env:"DB_TX_RETRY_MAX_DELAY" default:"1s"`
 }
 
 type JWTConfig struct {
-	Secret        string
-	Expiry        time.Duration
-	RefreshExpiry time.Duration
+	Secret        string        `yaml:"secret" env:"JWT_SECRET" secret:"true"`
+	Expiry        time.Duration `yaml:"expiry" env:"JWT_EXPIRY" default:"24h"`
+	RefreshExpiry time.Duration `yaml:"refresh_expiry" env:"JWT_REFRESH_EXPIRY" default:"168h"`
 }
 
 type CORSConfig struct {
-	AllowedOrigins   []string
-	AllowedMethods   []string
-	AllowedHeaders   []string
-	AllowCredentials bool
-	MaxAge           int
+	Preset           string   `yaml:"preset" env:"CORS_PRESET"`                                                                                                                   // development | strict (빈 값: local/dev → development, 그 외 → strict)
+	AllowedOrigins   []string `yaml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS"`                                                                                                 // 정확한 Origin, https://*.example.com, http://localhost:*, regex:<패턴>, * (비어 있으면 preset 기본값)
+	AllowedMethods   []string `yaml:"allowed_methods" env:"CORS_ALLOWED_METHODS" default:"GET,POST,PUT,DELETE,OPTIONS"`                                                           // 허용 메서드
+	AllowedHeaders   []string `yaml:"allowed_headers" env:"CORS_ALLOWED_HEADERS" default:"Origin,Content-Type,Accept,Accept-Language,Authorization,Idempotency-Key,X-Request-ID"` // 허용 요청 헤더
+	ExposedHeaders   []string `yaml:"exposed_headers" env:"CORS_EXPOSED_HEADERS" default:"X-Request-ID,Content-Language,Retry-After,Idempotent-Replayed,X-Consent-Required"`      // 브라우저 JS에 노출할 응답 헤더
+	AllowCredentials bool     `yaml:"allow_credentials" env:"CORS_ALLOW_CREDENTIALS" default:"true"`                                                                              // 쿠키/Authorization 포함 요청 허용
+	MaxAge           int      `yaml:"max_age" env:"CORS_MAX_AGE" default:"86400"`                                                                                                 // preflight 캐시 시간(초)
 }
 
 type ServerConfig struct {
-	ReadTimeout     time.Duration
-	WriteTimeout    time.Duration
-	IdleTimeout     time.Duration
-	GracefulTimeout time.Duration
+	ReadTimeout     time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT" default:"15s"`
+	WriteTimeout    time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT" default:"15s"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout" env:"SERVER_IDLE_TIMEOUT" default:"60s"`
+	GracefulTimeout time.Duration `yaml:"graceful_timeout" env:"GRACEFUL_TIMEOUT" default:"30s"`
+	RequestTimeout  time.Duration `yaml:"request_timeout" env:"SERVER_REQUEST_TIMEOUT" default:"30s"` // 요청 처리 제한 시간 (재시작 없이 변경 가능)
+
+	// Per-route request timeouts, key "METHOD /route/pattern" or "/route/pattern" (e.g. "GET /api/v1/reports/export=5m")
+	RouteTimeouts map[string]time.Duration `yaml:"route_timeouts" env:"SERVER_ROUTE_TIMEOUTS"`
+	// true: 제한 시간이 지나면 handler 종료를 기다리지 않고 504 응답 (응답을 버퍼링), false: context 취소만
+	EnforceTimeout bool `yaml:"enforce_timeout" env:"SERVER_ENFORCE_TIMEOUT" default:"true"`
+
+	// HTTPS: cert/key 파일을 모두 지정하면 TLS로 서비스 (파일이 바뀌면 재시작 없이 새 인증서 사용)
+	TLSCertFile       string        `yaml:"tls_cert_file" env:"SERVER_TLS_CERT_FILE"`
+	TLSKeyFile        string        `yaml:"tls_key_file" env:"SERVER_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `yaml:"tls_reload_interval" env:"SERVER_TLS_RELOAD_INTERVAL" default:"1m"` // 인증서 파일 변경 확인 주기
+	HTTPRedirectPort  int           `yaml:"http_redirect_port" env:"SERVER_HTTP_REDIRECT_PORT"`                // TLS 사용 시 이 포트의 HTTP 요청을 HTTPS로 redirect (0: 비활성화)
+}
+
+// TLSEnabled reports whether the server serves HTTPS
+func (s ServerConfig) TLSEnabled() bool {
+	return s.TLSCertFile != "" && s.TLSKeyFile != ""
+}
+
+type ProxyConfig struct {
+	TrustedProxies  []string `yaml:"trusted_proxies" env:"TRUSTED_PROXIES"`                                         // 클라이언트 IP 헤더를 신뢰할 프록시 IP/CIDR (비어 있으면 헤더 무시, 접속 IP 사용)
+	TrustedPlatform string   `yaml:"trusted_platform" env:"TRUSTED_PLATFORM"`                                       // 플랫폼 클라이언트 IP 헤더: cloudflare | oci | 헤더 이름 (신뢰 프록시에서 온 요청만 적용)
+	RemoteIPHeaders []string `yaml:"remote_ip_headers" env:"REMOTE_IP_HEADERS" default:"X-Forwarded-For,X-Real-IP"` // 신뢰 프록시가 보내는 클라이언트 IP 헤더
+}
+
+// platformIPHeaders maps TrustedPlatform presets to the header carrying the client IP
+var platformIPHeaders = map[string]string{
+	"cloudflare": "CF-Connecting-IP",
+	"oci":        "X-Real-IP", // Oracle Cloud Load Balancer
+}
+
+// PlatformIPHeader returns the client IP header of TrustedPlatform ("" if not set)
+func (p ProxyConfig) PlatformIPHeader() string {
+	if header, ok := platformIPHeaders[strings.ToLower(p.TrustedPlatform)]; ok {
+		return header
+	}
+	return p.TrustedPlatform
+}
+
+type SecurityConfig struct {
+	Headers               bool          `yaml:"headers" env:"SECURITY_HEADERS" default:"true"`                                                                       // false: 보안 헤더 미설정
+	HSTSMaxAge            time.Duration `yaml:"hsts_max_age" env:"SECURITY_HSTS_MAX_AGE" default:"8760h"`                                                            // HTTPS 응답의 Strict-Transport-Security max-age (0: 미설정)
+	HSTSIncludeSubdomains bool          `yaml:"hsts_include_subdomains" env:"SECURITY_HSTS_INCLUDE_SUBDOMAINS" default:"true"`                                       // includeSubDomains 지시자
+	HSTSPreload           bool          `yaml:"hsts_preload" env:"SECURITY_HSTS_PRELOAD" default:"false"`                                                            // preload 지시자 (hstspreload.org 등록 시)
+	ContentTypeOptions    string        `yaml:"content_type_options" env:"SECURITY_CONTENT_TYPE_OPTIONS" default:"nosniff"`                                          // X-Content-Type-Options (빈 값: 미설정)
+	FrameOptions          string        `yaml:"frame_options" env:"SECURITY_FRAME_OPTIONS" default:"DENY"`                                                           // X-Frame-Options (빈 값: 미설정)
+	ReferrerPolicy        string        `yaml:"referrer_policy" env:"SECURITY_REFERRER_POLICY" default:"strict-origin-when-cross-origin"`                            // Referrer-Policy (빈 값: 미설정)
+	ContentSecurityPolicy string        `yaml:"content_security_policy" env:"SECURITY_CONTENT_SECURITY_POLICY" default:"default-src 'self'; frame-ancestors 'none'"` // HTML 응답의 Content-Security-Policy (빈 값: 미설정)
+}
+
+type OutboxConfig struct {
+	PollInterval   time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL" default:"2s"`
+	BatchSize      int           `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE" default:"50"`
+	MaxAttempts    int           `yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS" default:"10"`   // 초과 시 dead-letter (DEAD) 처리
+	ClaimTimeout   time.Duration `yaml:"claim_timeout" env:"OUTBOX_CLAIM_TIMEOUT" default:"5m"` // 처리 중(PROCESSING) 이벤트를 다시 처리하기까지의 시간 (인스턴스 중단 대비)
+	RetryBaseDelay time.Duration `yaml:"retry_base_delay" env:"OUTBOX_RETRY_BASE_DELAY" default:"5s"`
+	RetryMaxDelay  time.Duration `yaml:"retry_max_delay" env:"OUTBOX_RETRY_MAX_DELAY" default:"10m"`
+	PurgeSchedule  string        `yaml:"purge_schedule" env:"OUTBOX_PURGE_SCHEDULE" default:"0 3 * * *"` // 처리 완료 이벤트 삭제 주기 (cron, UTC)
+	Retention      time.Duration `yaml:"retention" env:"OUTBOX_RETENTION" default:"168h"`                // 처리 완료 이벤트 보관 기간
+}
+
+type JobConfig struct {
+	Workers       int           `yaml:"workers" env:"JOB_WORKERS" default:"4"`
+	PollInterval  time.Duration `yaml:"poll_interval" env:"JOB_POLL_INTERVAL" default:"5s"` // 지연 Job 조회 주기
+	LeaseTTL      time.Duration `yaml:"lease_ttl" env:"JOB_LEASE_TTL" default:"5m"`         // 주기 Job lease / 지연 Job 잠금 시간 (실행 중에는 1/3 주기로 연장)
+	MaxAttempts   int           `yaml:"max_attempts" env:"JOB_MAX_ATTEMPTS" default:"5"`
+	RetryDelay    time.Duration `yaml:"retry_delay" env:"JOB_RETRY_DELAY" default:"1m"`               // 실패 시 attempts * RetryDelay 후 재시도
+	PurgeSchedule string        `yaml:"purge_schedule" env:"JOB_PURGE_SCHEDULE" default:"30 3 * * *"` // 완료된 지연 Job 삭제 주기 (cron, UTC)
+	Retention     time.Duration `yaml:"retention" env:"JOB_RETENTION" default:"168h"`                 // 완료된 지연 Job 보관 기간
+}
+
+type SecretsConfig struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval" env:"SECRET_REFRESH_INTERVAL" default:"5m"` // secret 참조 재조회 주기 (0: 비활성화)
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" env:"LOG_LEVEL"` // debug|info|warn|error (비어 있으면 환경별 기본값, 재시작 없이 변경 가능)
+}
+
+type ReloadConfig struct {
+	WatchInterval time.Duration `yaml:"watch_interval" env:"CONFIG_WATCH_INTERVAL" default:"5s"` // 설정 파일 변경 감지 주기 (0: 비활성화, SIGHUP은 항상 동작)
+}
+
+type ErrorConfig struct {
+	Format          string `yaml:"format" env:"ERROR_FORMAT" default:"legacy"`      // legacy: {status,code,message}, problem: RFC 7807 (Accept: application/problem+json 은 항상 problem)
+	ProblemTypeBase string `yaml:"problem_type_base" env:"ERROR_PROBLEM_TYPE_BASE"` // problem type URI prefix (비어 있으면 about:blank)
+}
+
+type BodyConfig struct {
+	MaxBytes      int64            `yaml:"max_bytes" env:"BODY_MAX_BYTES" default:"1048576"`   // 요청 body 최대 크기 (gzip 요청은 압축 해제 후 크기도 동일하게 제한)
+	RouteMaxBytes map[string]int64 `yaml:"route_max_bytes" env:"BODY_ROUTE_MAX_BYTES"`         // 라우트별 최대 크기, 키 "METHOD /route" 또는 "/route"
+	StrictJSON    bool             `yaml:"strict_json" env:"BODY_STRICT_JSON" default:"false"` // true: 요청 DTO에 없는 JSON 필드가 있으면 400
+}
+
+type ResponseConfig struct {
+	Compression     bool `yaml:"compression" env:"RESPONSE_COMPRESSION" default:"true"`             // Accept-Encoding에 따라 br/gzip 압축
+	CompressMinSize int  `yaml:"compress_min_size" env:"RESPONSE_COMPRESS_MIN_SIZE" default:"1024"` // 이보다 작은 응답은 압축하지 않음 (bytes)
+	ETag            bool `yaml:"etag" env:"RESPONSE_ETAG" default:"true"`                           // GET 응답에 weak ETag, If-None-Match 일치 시 304
 }
 
+type IdempotencyConfig struct {
+	Store         string        `yaml:"store" env:"IDEMPOTENCY_STORE" default:"database"`                     // database | memory (memory: 단일 인스턴스 전용)
+	TTL           time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" default:"24h"`                              // 응답 보관 기간 (이 기간 동안 같은 키 재시도에 응답 재전송)
+	LockTTL       time.Duration `yaml:"lock_ttl" env:"IDEMPOTENCY_LOCK_TTL" default:"1m"`                     // 처리 중 예약 만료 시간 (처리 중 서버가 종료된 경우 대비)
+	PurgeSchedule string        `yaml:"purge_schedule" env:"IDEMPOTENCY_PURGE_SCHEDULE" default:"45 3 * * *"` // 만료된 키 삭제 주기 (cron, UTC)
+}
+
+type HealthConfig struct {
+	CheckTimeout     time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT" default:"2s"`                    // 체크별 제한 시간
+	CacheTTL         time.Duration `yaml:"cache_ttl" env:"HEALTH_CACHE_TTL" default:"5s"`                            // 체크 결과 캐시 시간 (probe 요청마다 DB를 두드리지 않도록)
+	DrainDelay       time.Duration `yaml:"drain_delay" env:"HEALTH_DRAIN_DELAY" default:"5s"`                        // 종료 시 readiness 실패 후 서버 종료까지 대기 (LB가 트래픽을 빼는 시간)
+	OutboxMaxLag     time.Duration `yaml:"outbox_max_lag" env:"HEALTH_OUTBOX_MAX_LAG" default:"5m"`                  // 가장 오래된 미처리 outbox 이벤트 허용 지연 (0: 체크 안 함)
+	DiskPath         string        `yaml:"disk_path" env:"HEALTH_DISK_PATH" default:"."`                             // 여유 공간을 확인할 경로
+	DiskMinFreeBytes int64         `yaml:"disk_min_free_bytes" env:"HEALTH_DISK_MIN_FREE_BYTES" default:"104857600"` // 최소 여유 공간 (0: 체크 안 함)
+}
+
+type InternalConfig struct {
+	Token string `yaml:"token" env:"INTERNAL_TOKEN" secret:"true"` // X-Internal-Token 헤더로 내부 전용 정보/엔드포인트 접근 (빈 값: 비활성화)
+}
+
+type ClientConfig struct {
+	IOSMinVersion        string `yaml:"ios_min_version" env:"CLIENT_IOS_MIN_VERSION"`                         // 이보다 낮은 iOS 앱은 강제 업데이트 (빈 값: 제한 없음)
+	IOSLatestVersion     string `yaml:"ios_latest_version" env:"CLIENT_IOS_LATEST_VERSION"`                   // 최신 iOS 앱 버전 (선택 업데이트 안내용)
+	AndroidMinVersion    string `yaml:"android_min_version" env:"CLIENT_ANDROID_MIN_VERSION"`                 // 이보다 낮은 Android 앱은 강제 업데이트 (빈 값: 제한 없음)
+	AndroidLatestVersion string `yaml:"android_latest_version" env:"CLIENT_ANDROID_LATEST_VERSION"`           // 최신 Android 앱 버전
+	EnforceMinVersion    bool   `yaml:"enforce_min_version" env:"CLIENT_ENFORCE_MIN_VERSION" default:"false"` // true: 최소 버전 미만 X-App-Version 요청을 426 (ERROR-010)으로 거부
+}
+
+type LegalConfig struct {
+	CacheTTL time.Duration `yaml:"cache_ttl" env:"LEGAL_CACHE_TTL" default:"1m"` // 현재 시행 중인 약관 목록 캐시 시간 (새 버전 시행 반영 지연)
+}
+
+type FeatureConfig struct {
+	CacheTTL   time.Duration `yaml:"cache_ttl" env:"FEATURE_CACHE_TTL" default:"30s"`    // 기능 플래그/점검 모드 캐시 시간 (다른 인스턴스에 변경이 반영되는 최대 지연)
+	RetryAfter time.Duration `yaml:"retry_after" env:"FEATURE_RETRY_AFTER" default:"5m"` // 종료 시각 없는 점검 모드 응답의 Retry-After
+}
+
+// ShutdownConfig bounds the shutdown steps that have no timeout of their own.
+// HTTP drain uses GRACEFUL_TIMEOUT and the pre-stop delay HEALTH_DRAIN_DELAY.
+type ShutdownConfig struct {
+	StepTimeout   time.Duration `yaml:"step_timeout" env:"SHUTDOWN_STEP_TIMEOUT" default:"5s"`      // 단계별 기본 제한 시간 (설정 감시, DB 종료 등)
+	WorkerTimeout time.Duration `yaml:"worker_timeout" env:"SHUTDOWN_WORKER_TIMEOUT" default:"20s"` // 실행 중인 job 완료 대기 (초과 시 취소)
+	FlushTimeout  time.Duration `yaml:"flush_timeout" env:"SHUTDOWN_FLUSH_TIMEOUT" default:"10s"`   // 남은 outbox 이벤트 전달
+}
+
+// Client platforms sent in the X-App-Platform header
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+)
+
+// Versions returns the minimum and latest app versions of platform (ok=false for an unknown platform)
+func (c ClientConfig) Versions(platform string) (minVersion, latestVersion string, ok bool) {
+	switch strings.ToLower(platform) {
+	case PlatformIOS:
+		return c.IOSMinVersion, c.IOSLatestVersion, true
+	case PlatformAndroid:
+		return c.AndroidMinVersion, c.AndroidLatestVersion, true
+	}
+	return "", "", false
+}
+
+// MaxBytesFor returns the body size limit of a route: "METHOD /pattern" first,
+// then "/pattern" for any method, then MaxBytes.
+func (b BodyConfig) MaxBytesFor(method, route string) int64 {
+	if limit, ok := lookupRoute(b.RouteMaxBytes, method, route); ok {
+		return limit
+	}
+	return b.MaxBytes
+}
+
+// Load loads configuration for env from the default config directory and environment variables
 func Load(env string) (*Config, error) {
-	if err := loadEnvFile(env); err != nil {
-		return nil, fmt.Errorf("환경 변수 로드 실패: %w", err)
-	}
-
-	cfg := &Config{
-		App: AppConfig{
-			Name: getEnv("APP_NAME", "pray-together-api"),
-			Env:  env,
-			Port: getEnvAsInt("APP_PORT", 8080),
-		},
-		Database: DatabaseConfig{
-			Host:            getEnv("DB_HOST", ""),
-			Port:            getEnvAsInt("DB_PORT", 1521),
-			Service:         getEnv("DB_SERVICE", ""),
-			User:            getEnv("DB_USER", ""),
-			Password:        getEnv("DB_PASSWORD", ""),
-			MaxIdleConns:    getEnvAsInt("DB_MAX_IDLE_CONNS", 10),
-			MaxOpenConns:    getEnvAsInt("DB_MAX_OPEN_CONNS", 100),
-			ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", "1h"),
-			ConnMaxIdleTime: getEnvAsDuration("DB_CONN_MAX_IDLE_TIME", "10m"),
-			IsAutoMigrate:   getEnvAsBool("DB_AUTO_MIGRATE", false), // 기본값: false (안전)
-		},
-		JWT: JWTConfig{
-			Secret:        getEnv("JWT_SECRET", ""),
-			Expiry:        getEnvAsDuration("JWT_EXPIRY", "24h"),
-			RefreshExpiry: getEnvAsDuration("JWT_REFRESH_EXPIRY", "168h"),
-		},
-		CORS: CORSConfig{
-			AllowedOrigins:   getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"*"}),
-			AllowedMethods:   getEnvAsSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
-			AllowedHeaders:   getEnvAsSlice("CORS_ALLOWED_HEADERS", []string{"*"}),
-			AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", true),
-			MaxAge:           getEnvAsInt("CORS_MAX_AGE", 86400),
-		},
-		Server: ServerConfig{
-			ReadTimeout:     getEnvAsDuration("SERVER_READ_TIMEOUT", "15s"),
-			WriteTimeout:    getEnvAsDuration("SERVER_WRITE_TIMEOUT", "15s"),
-			IdleTimeout:     getEnvAsDuration("SERVER_IDLE_TIMEOUT", "60s"),
-			GracefulTimeout: getEnvAsDuration("GRACEFUL_TIMEOUT", "30s"),
-		},
-	}
-
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("환경 변수 검증 실패 : %w", err)
-	}
-
-	return cfg, nil
-}
-
-func loadEnvFile(env string) error {
-	envFile := fmt.Sprintf(".env.%s", env)
-
-	if _, err := os.Stat(envFile); os.IsNotExist(err) {
-		slog.Warn("환경 변수 파일을 찾을 수 없습니다. 시스템 환경 변수를 사용합니다.",
-			"file", envFile)
-		return nil
-	}
-
-	if err := godotenv.Load(envFile); err != nil {
-		return fmt.Errorf("환경 변수 파일 로드 오류: %s: %w", envFile, err)
-	}
-
-	absPath, _ := filepath.Abs(envFile)
-	slog.Info("환경 변수 파일 로드", "file", absPath)
-	return nil
+	return LoadWithOptions(LoadOptions{Env: env})
 }
 
 func (c *Config) Validate() error {
@@ -135,6 +273,9 @@ func (c *Config) Validate() error {
 	if c.App.Port < 1 || c.App.Port > 65535 {
 		errors = append(errors, "유효하지 않은 포트 번호")
 	}
+	if !slices.Contains(Environments, c.App.Env) {
+		errors = append(errors, fmt.Sprintf("알 수 없는 환경입니다: env=%q (%s 중 하나)", c.App.Env, strings.Join(Environments, ", ")))
+	}
 
 	// Database validation
 	if c.Database.Host == "" {
@@ -149,6 +290,9 @@ func (c *Config) Validate() error {
 	if c.Database.Password == "" {
 		errors = append(errors, "데이터베이스 Password가 필요합니다")
 	}
+	if c.Database.TxRetryBaseDelay < 0 || c.Database.TxRetryMaxDelay < 0 {
+		errors = append(errors, "트랜잭션 재시도 지연 시간은 0 이상이어야 합니다")
+	}
 
 	// JWT validation
 	if c.JWT.Secret == "" {
@@ -158,6 +302,147 @@ func (c *Config) Validate() error {
 		errors = append(errors, "JWT Secret Key는 32자 이상이어야 합니다")
 	}
 
+	// Server validation
+	if c.Server.RequestTimeout <= 0 {
+		errors = append(errors, "요청 처리 제한 시간은 0보다 커야 합니다")
+	}
+	for route, timeout := range c.Server.RouteTimeouts {
+		if timeout <= 0 {
+			errors = append(errors, fmt.Sprintf("라우트 제한 시간은 0보다 커야 합니다: %s", route))
+		}
+		if !validRouteKey(route) {
+			errors = append(errors, fmt.Sprintf("라우트 제한 시간 키는 \"METHOD /path\" 형식이어야 합니다: %s", route))
+		}
+	}
+
+	if (c.Server.TLSCertFile == "") != (c.Server.TLSKeyFile == "") {
+		errors = append(errors, "TLS 인증서와 키 파일은 함께 지정해야 합니다")
+	}
+	if c.Server.HTTPRedirectPort != 0 {
+		if !c.Server.TLSEnabled() {
+			errors = append(errors, "HTTP redirect 포트는 TLS 사용 시에만 지정할 수 있습니다")
+		}
+		if c.Server.HTTPRedirectPort < 1 || c.Server.HTTPRedirectPort > 65535 || c.Server.HTTPRedirectPort == c.App.Port {
+			errors = append(errors, "유효하지 않은 HTTP redirect 포트")
+		}
+	}
+
+	// Proxy validation
+	for _, proxy := range c.Proxy.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			errors = append(errors, fmt.Sprintf("유효하지 않은 신뢰 프록시 IP/CIDR: %s", proxy))
+		}
+	}
+	if c.Proxy.TrustedPlatform != "" && len(c.Proxy.TrustedProxies) == 0 {
+		errors = append(errors, "신뢰 플랫폼 헤더를 사용하려면 신뢰 프록시(TRUSTED_PROXIES)를 지정해야 합니다")
+	}
+
+	// CORS validation
+	errors = append(errors, c.CORS.validate(c.IsProduction())...)
+
+	// Security header validation
+	if c.Security.HSTSMaxAge < 0 {
+		errors = append(errors, "HSTS max-age는 0 이상이어야 합니다")
+	}
+
+	// Request body validation
+	if c.Body.MaxBytes <= 0 {
+		errors = append(errors, "요청 body 최대 크기는 0보다 커야 합니다")
+	}
+	for route, limit := range c.Body.RouteMaxBytes {
+		if limit <= 0 {
+			errors = append(errors, fmt.Sprintf("라우트 body 최대 크기는 0보다 커야 합니다: %s", route))
+		}
+		if !validRouteKey(route) {
+			errors = append(errors, fmt.Sprintf("라우트 body 최대 크기 키는 \"METHOD /path\" 형식이어야 합니다: %s", route))
+		}
+	}
+
+	// Response validation
+	if c.Response.CompressMinSize < 0 {
+		errors = append(errors, "응답 압축 최소 크기는 0 이상이어야 합니다")
+	}
+
+	// Idempotency validation
+	if c.Idempotency.Store != "database" && c.Idempotency.Store != "memory" {
+		errors = append(errors, fmt.Sprintf("유효하지 않은 idempotency 저장소: %s (database|memory)", c.Idempotency.Store))
+	}
+	if c.Idempotency.TTL <= 0 || c.Idempotency.LockTTL <= 0 {
+		errors = append(errors, "Idempotency TTL과 lock TTL은 0보다 커야 합니다")
+	}
+
+	// Health validation
+	if c.Health.CheckTimeout <= 0 {
+		errors = append(errors, "Health 체크 제한 시간은 0보다 커야 합니다")
+	}
+	if c.Health.CacheTTL < 0 || c.Health.DrainDelay < 0 || c.Health.OutboxMaxLag < 0 || c.Health.DiskMinFreeBytes < 0 {
+		errors = append(errors, "Health 캐시/drain/outbox 지연/디스크 여유 공간 설정은 0 이상이어야 합니다")
+	}
+	if c.Internal.Token != "" && len(c.Internal.Token) < 32 {
+		errors = append(errors, "내부 토큰(INTERNAL_TOKEN)은 32자 이상이어야 합니다")
+	}
+
+	// Legal validation
+	if c.Legal.CacheTTL < 0 {
+		errors = append(errors, "약관 캐시 시간은 0 이상이어야 합니다")
+	}
+
+	// Feature validation
+	if c.Feature.CacheTTL < 0 {
+		errors = append(errors, "기능 플래그 캐시 시간은 0 이상이어야 합니다")
+	}
+	if c.Feature.RetryAfter <= 0 {
+		errors = append(errors, "점검 모드 Retry-After는 0보다 커야 합니다")
+	}
+
+	// Shutdown validation
+	if c.Shutdown.StepTimeout <= 0 || c.Shutdown.WorkerTimeout <= 0 || c.Shutdown.FlushTimeout <= 0 {
+		errors = append(errors, "종료 단계 제한 시간(SHUTDOWN_*_TIMEOUT)은 0보다 커야 합니다")
+	}
+
+	// Client version validation
+	for _, platform := range []string{PlatformIOS, PlatformAndroid} {
+		minVersion, latestVersion, _ := c.Client.Versions(platform)
+		errors = append(errors, validateClientVersions(platform, minVersion, latestVersion)...)
+	}
+
+	// Log validation
+	switch strings.ToLower(c.Log.Level) {
+	case "", "debug", "info", "warn", "error":
+	default:
+		errors = append(errors, fmt.Sprintf("유효하지 않은 로그 레벨: %s", c.Log.Level))
+	}
+
+	// Error response validation
+	if c.Error.Format != "legacy" && c.Error.Format != "problem" {
+		errors = append(errors, fmt.Sprintf("유효하지 않은 에러 응답 형식: %s (legacy|problem)", c.Error.Format))
+	}
+
+	// Outbox validation
+	if c.Outbox.PollInterval <= 0 {
+		errors = append(errors, "Outbox 폴링 주기는 0보다 커야 합니다")
+	}
+	if c.Outbox.BatchSize < 1 {
+		errors = append(errors, "Outbox 배치 크기는 1 이상이어야 합니다")
+	}
+	if c.Outbox.MaxAttempts < 1 {
+		errors = append(errors, "Outbox 최대 시도 횟수는 1 이상이어야 합니다")
+	}
+	if c.Outbox.ClaimTimeout <= 0 {
+		errors = append(errors, "Outbox 처리 제한 시간은 0보다 커야 합니다")
+	}
+
+	// Job validation
+	if c.Job.Workers < 1 {
+		errors = append(errors, "Job 워커 수는 1 이상이어야 합니다")
+	}
+	if c.Job.PollInterval <= 0 || c.Job.LeaseTTL <= 0 {
+		errors = append(errors, "Job 폴링 주기와 lease 시간은 0보다 커야 합니다")
+	}
+	if c.Job.MaxAttempts < 1 {
+		errors = append(errors, "Job 최대 시도 횟수는 1 이상이어야 합니다")
+	}
+
 	if len(errors) > 0 {
 		return fmt.Errorf("유효성 검사 오류: %s", strings.Join(errors, ", "))
 	}
@@ -165,6 +450,31 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// validateClientVersions checks that the configured versions parse and that latest is not below min
+func validateClientVersions(platform, minVersion, latestVersion string) []string {
+	var errors []string
+	parsed := make([]appversion.Version, 0, 2)
+	for _, v := range []string{minVersion, latestVersion} {
+		if v == "" {
+			continue
+		}
+		version, err := appversion.Parse(v)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s 앱 버전 설정 오류: %v", platform, err))
+			continue
+		}
+		parsed = append(parsed, version)
+	}
+
+	if len(parsed) == 2 && parsed[1].Less(parsed[0]) {
+		errors = append(errors, fmt.Sprintf("%s 최신 앱 버전(%s)은 최소 버전(%s) 이상이어야 합니다", platform, latestVersion, minVersion))
+	}
+	return errors
+}
+
+// Environments are the accepted -env values
+var Environments = []string{"local", "dev", "test", "prod"}
+
 func (c *Config) IsDevelopment() bool {
 	return c.App.Env == "local" || c.App.Env == "dev"
 }
@@ -182,46 +492,3 @@ func (c *Config) GetDSN() string {
 		c.Database.Service,
 	)
 }
-
-// Helper functions
-func getEnv(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
-	}
-	return defaultValue
-}
-
-func getEnvAsInt(key string, defaultValue int) int {
-	valueStr := getEnv(key, "")
-	if value, err := strconv.Atoi(valueStr); err == nil {
-		return value
-	}
-	return defaultValue
-}
-
-func getEnvAsBool(key string, defaultValue bool) bool {
-	valueStr := getEnv(key, "")
-	if value, err := strconv.ParseBool(valueStr); err == nil {
-		return value
-	}
-	return defaultValue
-}
-
-func getEnvAsSlice(key string, defaultValue []string) []string {
-	valueStr := getEnv(key, "")
-	if valueStr == "" {
-		return defaultValue
-	}
-	return strings.Split(valueStr, ",")
-}
-
-func getEnvAsDuration(key string, defaultValue string) time.Duration {
-	valueStr := getEnv(key, defaultValue)
-	if duration, err := time.ParseDuration(valueStr); err == nil {
-		return duration
-	}
-	if defaultDuration, err := time.ParseDuration(defaultValue); err == nil {
-		return defaultDuration
-	}
-	return 0
-}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	CORSPresetDevelopment = "development"
+	CORSPresetStrict      = "strict"
+
+	// regexOriginPrefix marks an AllowedOrigins entry as a regular expression matched against the whole Origin
+	regexOriginPrefix = "regex:"
+)
+
+// corsPresetOrigins are the allowed origins of each preset when AllowedOrigins is empty.
+// strict allows no cross-origin request until origins are listed explicitly.
+var corsPresetOrigins = map[string][]string{
+	CORSPresetDevelopment: {"http://localhost:*", "http://127.0.0.1:*"},
+	CORSPresetStrict:      nil,
+}
+
+// applyPreset resolves an empty Preset from env and fills AllowedOrigins from it when not set
+func (c *CORSConfig) applyPreset(env string) {
+	if c.Preset == "" {
+		c.Preset = CORSPresetStrict
+		if env == "local" || env == "dev" {
+			c.Preset = CORSPresetDevelopment
+		}
+	}
+	if len(c.AllowedOrigins) == 0 {
+		c.AllowedOrigins = slices.Clone(corsPresetOrigins[c.Preset])
+	}
+}
+
+// AllowsAllOrigins reports whether AllowedOrigins contains "*"
+func (c CORSConfig) AllowsAllOrigins() bool {
+	return slices.Contains(c.AllowedOrigins, "*")
+}
+
+// OriginMatcher compiles AllowedOrigins into a function reporting whether an Origin header is allowed.
+//
+//   - https://app.example.com: exact match (case-insensitive)
+//   - https://*.example.com: any subdomain, at any depth, of example.com (not example.com itself)
+//   - http://localhost:*: any port
+//   - regex:^https://pr-\d+\.preview\.example\.com$: regular expression, anchored to the whole (lowercased) Origin
+//   - *: every origin
+func (c CORSConfig) OriginMatcher() (func(origin string) bool, error) {
+	var matchers []func(string) bool
+	for _, pattern := range c.AllowedOrigins {
+		m, err := compileOrigin(pattern)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	return func(origin string) bool {
+		origin = strings.ToLower(origin)
+		for _, m := range matchers {
+			if m(origin) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+var (
+	hostLabels = regexp.MustCompile(`^[a-z0-9-]+(\.[a-z0-9-]+)*$`)
+	portDigits = regexp.MustCompile(`^[0-9]{1,5}$`)
+)
+
+func compileOrigin(pattern string) (func(string) bool, error) {
+	if expr, ok := strings.CutPrefix(pattern, regexOriginPrefix); ok {
+		re, err := regexp.Compile(`^(?:` + expr + `)$`)
+		if err != nil {
+			return nil, fmt.Errorf("CORS Origin 정규식 오류: %s: %w", pattern, err)
+		}
+		return re.MatchString, nil
+	}
+
+	if pattern == "*" {
+		return func(string) bool { return true }, nil
+	}
+
+	pattern = strings.ToLower(pattern)
+	prefix, suffix, wildcard := strings.Cut(pattern, "*")
+	if err := validateOriginPattern(pattern, prefix, suffix, wildcard); err != nil {
+		return nil, err
+	}
+	if !wildcard {
+		return func(origin string) bool { return origin == pattern }, nil
+	}
+
+	// Subdomain wildcard: "*" replaces one or more host labels
+	matched := hostLabels
+	if suffix == "" {
+		// Port wildcard: "*" replaces the port number
+		matched = portDigits
+	}
+	return func(origin string) bool {
+		if len(origin) <= len(prefix)+len(suffix) || !strings.HasPrefix(origin, prefix) || !strings.HasSuffix(origin, suffix) {
+			return false
+		}
+		return matched.MatchString(origin[len(prefix) : len(origin)-len(suffix)])
+	}, nil
+}
+
+// validateOriginPattern checks that an exact or wildcard origin is "scheme://host[:port]" and
+// that a wildcard only stands for subdomains ("scheme://*.domain") or the port ("scheme://host:*")
+func validateOriginPattern(pattern, prefix, suffix string, wildcard bool) error {
+	host := pattern
+	if wildcard {
+		switch {
+		case strings.HasSuffix(prefix, "://") && strings.HasPrefix(suffix, ".") && !strings.Contains(suffix, "*"):
+			host = prefix + "sub" + suffix
+		case strings.HasSuffix(prefix, ":") && suffix == "":
+			host = prefix + "80"
+		default:
+			return fmt.Errorf("CORS Origin 와일드카드는 scheme://*.domain 또는 scheme://host:* 형식이어야 합니다: %s", pattern)
+		}
+	}
+
+	u, err := url.Parse(host)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.User != nil {
+		return fmt.Errorf("CORS Origin은 scheme://host[:port] 형식이어야 합니다: %s", pattern)
+	}
+	return nil
+}
+
+// validate returns the CORS configuration errors; production additionally rejects insecure combinations
+func (c CORSConfig) validate(production bool) []string {
+	var errors []string
+
+	if _, ok := corsPresetOrigins[c.Preset]; !ok && c.Preset != "" {
+		errors = append(errors, fmt.Sprintf("유효하지 않은 CORS preset: %s (development|strict)", c.Preset))
+	}
+	if _, err := c.OriginMatcher(); err != nil {
+		errors = append(errors, err.Error())
+	}
+	if c.MaxAge < 0 {
+		errors = append(errors, "CORS max-age는 0 이상이어야 합니다")
+	}
+
+	if !production {
+		return errors
+	}
+
+	if c.AllowCredentials && c.AllowsAllOrigins() {
+		errors = append(errors, "운영 환경에서는 CORS Origin * 과 credentials를 함께 허용할 수 없습니다")
+	}
+	if c.AllowCredentials && slices.Contains(c.AllowedHeaders, "*") {
+		errors = append(errors, "운영 환경에서는 CORS 허용 헤더 * 와 credentials를 함께 사용할 수 없습니다")
+	}
+	for _, origin := range c.AllowedOrigins {
+		if strings.HasPrefix(strings.ToLower(origin), "http://") {
+			errors = append(errors, fmt.Sprintf("운영 환경에서는 https Origin만 허용할 수 있습니다: %s", origin))
+		}
+	}
+	return errors
+}
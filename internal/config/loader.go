@@ -0,0 +1,558 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultConfigDir = "config"
+	redactedValue    = "****"
+)
+
+// LoadOptions controls where configuration layers are read from.
+//
+// Layers are applied in order, later layers win:
+//  1. default tag
+//  2. base file          <ConfigDir>/config.{yaml,yml,toml}
+//  3. environment file   <ConfigDir>/config.<env>.{yaml,yml,toml}
+//  4. environment vars   (process environment, then .env.<env>; the file is copied into the
+//     process environment only once the configuration is valid)
+//  5. Overrides          (-set flags)
+type LoadOptions struct {
+	Env       string
+	ConfigDir string    // 기본값: CONFIG_DIR 환경 변수 또는 "config"
+	Overrides Overrides // key: 파일 경로(app.port) 또는 환경 변수명(APP_PORT)
+}
+
+// Overrides collects repeated -set key=value command line flags (implements flag.Value)
+type Overrides map[string]string
+
+func (o Overrides) String() string {
+	pairs := make([]string, 0, len(o))
+	for k, v := range o {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (o Overrides) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return fmt.Errorf("key=value 형식이어야 합니다: %q", value)
+	}
+	o[strings.TrimSpace(key)] = val
+	return nil
+}
+
+// field is a leaf configuration value bound to its struct field
+type field struct {
+	path   string // file key path (e.g. database.port)
+	env    string // environment variable (e.g. DB_PORT)
+	def    string // default value
+	hasDef bool
+	secret bool
+	value  reflect.Value
+}
+
+// layer is a parsed configuration file flattened to field paths
+type layer struct {
+	name   string
+	values map[string]any
+}
+
+// LoadWithOptions loads configuration from all layers and validates the result.
+// Malformed values (e.g. JWT_EXPIRY=abc) and unknown file keys are reported as errors.
+func LoadWithOptions(opts LoadOptions) (*Config, error) {
+	envFile, err := readEnvFile(opts.Env)
+	if err != nil {
+		return nil, fmt.Errorf("환경 변수 로드 실패: %w", err)
+	}
+
+	cfg := &Config{App: AppConfig{Env: opts.Env}}
+	fields := collectFields(reflect.ValueOf(cfg).Elem(), "")
+
+	dir := opts.ConfigDir
+	if dir == "" {
+		// CONFIG_DIR may come from the .env file, not applied to the process environment yet
+		dir, _ = envFile.lookup("CONFIG_DIR")
+	}
+	layers, err := loadFileLayers(configDir(dir), opts.Env, fields)
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일 로드 실패: %w", err)
+	}
+
+	if err := decode(fields, layers, envFile, opts.Overrides); err != nil {
+		return nil, fmt.Errorf("설정 값 해석 실패: %w", err)
+	}
+
+	if cfg.secretRefs, err = resolveSecrets(fields); err != nil {
+		return nil, fmt.Errorf("secret 조회 실패: %w", err)
+	}
+
+	cfg.CORS.applyPreset(cfg.App.Env)
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("환경 변수 검증 실패 : %w", err)
+	}
+
+	envFile.apply()
+	return cfg, nil
+}
+
+func configDir(dir string) string {
+	if dir != "" {
+		return dir
+	}
+	if dir := os.Getenv("CONFIG_DIR"); dir != "" {
+		return dir
+	}
+	return defaultConfigDir
+}
+
+// envFileKeys records the variables set from the .env file so a reload can update them.
+// Variables that were already in the process environment always win over the file.
+var (
+	envFileMu   sync.Mutex
+	envFileKeys = map[string]bool{}
+)
+
+// envFile is a parsed .env.<env> file, not yet applied to the process environment
+type envFile struct {
+	path   string
+	values map[string]string // nil when the file does not exist
+}
+
+// readEnvFile parses .env.<env> without touching the process environment
+func readEnvFile(env string) (*envFile, error) {
+	path := fmt.Sprintf(".env.%s", env)
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		slog.Warn("환경 변수 파일을 찾을 수 없습니다. 시스템 환경 변수를 사용합니다.",
+			"file", path)
+		return &envFile{path: path}, nil
+	}
+
+	values, err := godotenv.Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("환경 변수 파일 로드 오류: %s: %w", path, err)
+	}
+	return &envFile{path: path, values: values}, nil
+}
+
+// lookup returns the value of an environment variable: the process environment first,
+// unless the variable came from a previous load of the file, then the file.
+// Without a file the process environment is used as is, as apply leaves it unchanged.
+func (f *envFile) lookup(key string) (string, bool) {
+	if f.values == nil {
+		return os.LookupEnv(key)
+	}
+
+	envFileMu.Lock()
+	fromFile := envFileKeys[key]
+	envFileMu.Unlock()
+
+	if v, ok := os.LookupEnv(key); ok && !fromFile {
+		return v, true
+	}
+	v, ok := f.values[key]
+	return v, ok
+}
+
+// apply copies the file into the process environment, removing keys deleted from it since the last load
+func (f *envFile) apply() {
+	if f.values == nil {
+		return
+	}
+
+	envFileMu.Lock()
+	defer envFileMu.Unlock()
+
+	for key := range envFileKeys {
+		if _, ok := f.values[key]; !ok {
+			_ = os.Unsetenv(key)
+			delete(envFileKeys, key)
+		}
+	}
+	for key, value := range f.values {
+		if _, set := os.LookupEnv(key); set && !envFileKeys[key] {
+			continue
+		}
+		_ = os.Setenv(key, value)
+		envFileKeys[key] = true
+	}
+
+	absPath, _ := filepath.Abs(f.path)
+	slog.Info("환경 변수 파일 로드", "file", absPath)
+}
+
+// collectFields walks the config struct and returns every leaf field
+func collectFields(v reflect.Value, prefix string) []field {
+	var fields []field
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		key := sf.Tag.Get("yaml")
+		if key == "-" || !sf.IsExported() {
+			continue
+		}
+		if key == "" {
+			key = strings.ToLower(sf.Name)
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct && fv.Type() != reflect.TypeOf(time.Duration(0)) {
+			fields = append(fields, collectFields(fv, path)...)
+			continue
+		}
+
+		def, hasDef := sf.Tag.Lookup("default")
+		fields = append(fields, field{
+			path:   path,
+			env:    sf.Tag.Get("env"),
+			def:    def,
+			hasDef: hasDef,
+			secret: sf.Tag.Get("secret") == "true",
+			value:  fv,
+		})
+	}
+	return fields
+}
+
+// loadFileLayers reads the base and environment config files (both optional)
+func loadFileLayers(dir, env string, fields []field) ([]layer, error) {
+	known := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		known[f.path] = true
+	}
+
+	var layers []layer
+	for _, name := range []string{"config", "config." + env} {
+		path := findConfigFile(dir, name)
+		if path == "" {
+			continue
+		}
+
+		raw, err := readConfigFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]any)
+		if err := flatten("", raw, known, values); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		slog.Info("설정 파일 로드", "file", path)
+		layers = append(layers, layer{name: path, values: values})
+	}
+	return layers, nil
+}
+
+func findConfigFile(dir, name string) string {
+	for _, ext := range []string{".yaml", ".yml", ".toml"} {
+		path := filepath.Join(dir, name+ext)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+func readConfigFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일 읽기 실패: %w", err)
+	}
+
+	raw := make(map[string]any)
+	if strings.HasSuffix(path, ".toml") {
+		err = toml.Unmarshal(data, &raw)
+	} else {
+		err = yaml.Unmarshal(data, &raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일 파싱 실패: %s: %w", path, err)
+	}
+	return raw, nil
+}
+
+// flatten converts nested file maps into field paths, rejecting unknown keys
+func flatten(prefix string, m map[string]any, known map[string]bool, out map[string]any) error {
+	var errs []error
+	for k, v := range m {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+
+		if known[path] {
+			out[path] = v
+			continue
+		}
+		if sub, ok := v.(map[string]any); ok {
+			if err := flatten(path, sub, known, out); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		errs = append(errs, fmt.Errorf("알 수 없는 설정 키: %s", path))
+	}
+	return errors.Join(errs...)
+}
+
+// decode resolves every field from its highest-priority layer and parses it strictly
+func decode(fields []field, layers []layer, env *envFile, overrides Overrides) error {
+	var errs []string
+
+	for _, f := range fields {
+		var raw any
+		src := ""
+
+		if f.hasDef {
+			raw, src = f.def, "default"
+		}
+		for _, l := range layers {
+			if v, ok := l.values[f.path]; ok {
+				raw, src = v, l.name
+			}
+		}
+		// Empty environment values are treated as unset (e.g. "DB_PORT=" in .env files)
+		if f.env != "" {
+			if v, ok := env.lookup(f.env); ok && v != "" {
+				raw, src = v, "env"
+			}
+		}
+		for _, key := range []string{f.path, f.env} {
+			if v, ok := overrides[key]; ok && key != "" {
+				raw, src = v, "flag"
+			}
+		}
+
+		if raw == nil {
+			continue
+		}
+		if err := setValue(f.value, raw); err != nil {
+			errs = append(errs, fmt.Sprintf("%s (%s): %v", f.name(), src, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// resolveSecrets replaces secret references in secret fields with their values
+func resolveSecrets(fields []field) (*secretStore, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	store := newSecretStore()
+	var errs []string
+	for _, f := range fields {
+		if !f.secret || f.value.Kind() != reflect.String || !IsSecretRef(f.value.String()) {
+			continue
+		}
+
+		ref := f.value.String()
+		value, err := ResolveSecret(ctx, ref)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", f.name(), err))
+			continue
+		}
+
+		f.value.SetString(value)
+		store.refs[f.path] = ref
+		store.values[f.path] = value
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, ", "))
+	}
+	return store, nil
+}
+
+// name returns the identifier shown in error messages
+func (f field) name() string {
+	if f.env != "" {
+		return f.env
+	}
+	return f.path
+}
+
+// setValue parses raw (string, list or map from files/env) into v according to its type
+func setValue(v reflect.Value, raw any) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(scalar(raw))
+		if err != nil {
+			return fmt.Errorf("잘못된 기간 값 %q (예: 30s, 5m, 1h)", scalar(raw))
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(scalar(raw))
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(scalar(raw)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("정수가 아닙니다: %q", scalar(raw))
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(strings.TrimSpace(scalar(raw)), 64)
+		if err != nil {
+			return fmt.Errorf("숫자가 아닙니다: %q", scalar(raw))
+		}
+		v.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(scalar(raw)))
+		if err != nil {
+			return fmt.Errorf("true/false 값이 아닙니다: %q", scalar(raw))
+		}
+		v.SetBool(b)
+	case reflect.Slice:
+		items := list(raw)
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setValue(slice.Index(i), item); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		v.Set(slice)
+	case reflect.Map:
+		entries, err := mapEntries(raw)
+		if err != nil {
+			return err
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(entries))
+		for k, item := range entries {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := setValue(elem, item); err != nil {
+				return fmt.Errorf("[%s]: %w", k, err)
+			}
+			m.SetMapIndex(reflect.ValueOf(k), elem)
+		}
+		v.Set(m)
+	default:
+		return fmt.Errorf("지원하지 않는 설정 타입: %s", v.Type())
+	}
+	return nil
+}
+
+func scalar(raw any) string {
+	if s, ok := raw.(string); ok {
+		return s
+	}
+	return fmt.Sprint(raw)
+}
+
+// list accepts a file list or a comma separated string
+func list(raw any) []any {
+	if items, ok := raw.([]any); ok {
+		return items
+	}
+
+	var items []any
+	for _, item := range strings.Split(scalar(raw), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// mapEntries accepts a file map or a "key=value,key=value" string
+func mapEntries(raw any) (map[string]any, error) {
+	if m, ok := raw.(map[string]any); ok {
+		return m, nil
+	}
+
+	entries := make(map[string]any)
+	for _, pair := range strings.Split(scalar(raw), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("key=value 형식이 아닙니다: %q", pair)
+		}
+		entries[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return entries, nil
+}
+
+// Dump returns the effective configuration as a nested map keyed by file paths.
+// When redact is true, fields tagged secret:"true" are masked.
+func (c *Config) Dump(redact bool) map[string]any {
+	out := make(map[string]any)
+	out["app"] = map[string]any{"env": c.App.Env}
+
+	for _, f := range collectFields(reflect.ValueOf(c).Elem(), "") {
+		value := dumpValue(f.value)
+		if redact && f.secret && !f.value.IsZero() {
+			value = redactedValue
+		}
+
+		node := out
+		parts := strings.Split(f.path, ".")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				node[part] = child
+			}
+			node = child
+		}
+		node[parts[len(parts)-1]] = value
+	}
+	return out
+}
+
+// dumpValue converts a field into a printable value (durations as strings)
+func dumpValue(v reflect.Value) any {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		return time.Duration(v.Int()).String()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = dumpValue(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		m := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = dumpValue(iter.Value())
+		}
+		return m
+	default:
+		return v.Interface()
+	}
+}
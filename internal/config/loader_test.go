@@ -0,0 +1,188 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setRequiredEnv sets the values Validate requires
+func setRequiredEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DB_SERVICE", "test")
+	t.Setenv("DB_USER", "test")
+	t.Setenv("DB_PASSWORD", "test-password")
+	t.Setenv("JWT_SECRET", "test-jwt-secret-key-must-be-at-least-32-characters-long")
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestLoad_LayerPrecedence(t *testing.T) {
+	// Given: base yaml < env toml < env var < flag
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "app:\n  port: 9000\njwt:\n  expiry: 2h\nserver:\n  read_timeout: 1s\n")
+	writeFile(t, dir, "config.test.toml", "[jwt]\nexpiry = \"3h\"\n[server]\nread_timeout = \"2s\"\n")
+	t.Setenv("SERVER_READ_TIMEOUT", "3s")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://a.example, https://b.example")
+
+	// When
+	cfg, err := config.LoadWithOptions(config.LoadOptions{
+		Env:       "test",
+		ConfigDir: dir,
+		Overrides: config.Overrides{"server.read_timeout": "4s"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 9000, cfg.App.Port)                      // base file
+	assert.Equal(t, 3*time.Hour, cfg.JWT.Expiry)             // env file
+	assert.Equal(t, 4*time.Second, cfg.Server.ReadTimeout)   // flag
+	assert.Equal(t, 15*time.Second, cfg.Server.WriteTimeout) // default
+	assert.Equal(t, []string{"https://a.example", "https://b.example"}, cfg.CORS.AllowedOrigins)
+}
+
+func TestLoad_MalformedValuesAreErrors(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("JWT_EXPIRY", "abc")
+	t.Setenv("APP_PORT", "eighty")
+
+	_, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: t.TempDir()})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "JWT_EXPIRY")
+	assert.Contains(t, err.Error(), "APP_PORT")
+}
+
+func TestLoad_InvalidTrustedProxies(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8,not-an-ip")
+
+	_, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: t.TempDir()})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not-an-ip")
+}
+
+func TestLoad_CORSPreset(t *testing.T) {
+	tests := []struct {
+		env         string
+		wantPreset  string
+		wantOrigins []string
+	}{
+		{"local", config.CORSPresetDevelopment, []string{"http://localhost:*", "http://127.0.0.1:*"}},
+		{"prod", config.CORSPresetStrict, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			setRequiredEnv(t)
+
+			cfg, err := config.LoadWithOptions(config.LoadOptions{Env: tt.env, ConfigDir: t.TempDir()})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPreset, cfg.CORS.Preset)
+			assert.Equal(t, tt.wantOrigins, cfg.CORS.AllowedOrigins)
+			assert.Contains(t, cfg.CORS.ExposedHeaders, "X-Request-ID")
+		})
+	}
+}
+
+func TestLoad_InvalidCORS(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		vars    map[string]string
+		wantErr string
+	}{
+		{"wildcard with credentials in prod", "prod", map[string]string{"CORS_ALLOWED_ORIGINS": "*"}, "credentials"},
+		{"wildcard headers with credentials in prod", "prod", map[string]string{"CORS_ALLOWED_ORIGINS": "https://app.example.com", "CORS_ALLOWED_HEADERS": "*"}, "credentials"},
+		{"plain http origin in prod", "prod", map[string]string{"CORS_ALLOWED_ORIGINS": "http://app.example.com"}, "http://app.example.com"},
+		{"broad wildcard", "test", map[string]string{"CORS_ALLOWED_ORIGINS": "https://*example.com"}, "https://*example.com"},
+		{"origin with path", "test", map[string]string{"CORS_ALLOWED_ORIGINS": "https://app.example.com/"}, "https://app.example.com/"},
+		{"invalid regex", "test", map[string]string{"CORS_ALLOWED_ORIGINS": "regex:https://(a"}, "정규식"},
+		{"unknown preset", "test", map[string]string{"CORS_PRESET": "loose"}, "loose"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setRequiredEnv(t)
+			for key, value := range tt.vars {
+				t.Setenv(key, value)
+			}
+
+			_, err := config.LoadWithOptions(config.LoadOptions{Env: tt.env, ConfigDir: t.TempDir()})
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoad_WildcardWithoutCredentialsAllowedInProd(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("CORS_ALLOWED_ORIGINS", "*")
+	t.Setenv("CORS_ALLOW_CREDENTIALS", "false")
+
+	_, err := config.LoadWithOptions(config.LoadOptions{Env: "prod", ConfigDir: t.TempDir()})
+
+	require.NoError(t, err)
+}
+
+func TestLoad_UnknownEnvironment(t *testing.T) {
+	setRequiredEnv(t)
+
+	_, err := config.LoadWithOptions(config.LoadOptions{Env: "production", ConfigDir: t.TempDir()})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `env="production"`)
+}
+
+func TestLoad_InvalidClientVersions(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("CLIENT_IOS_MIN_VERSION", "1.x")
+	t.Setenv("CLIENT_ANDROID_MIN_VERSION", "2.0.0")
+	t.Setenv("CLIENT_ANDROID_LATEST_VERSION", "1.9.0")
+
+	_, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: t.TempDir()})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1.x")
+	assert.Contains(t, err.Error(), "android 최신 앱 버전")
+}
+
+func TestLoad_UnknownFileKeyIsError(t *testing.T) {
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "app:\n  prot: 9000\n")
+
+	_, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: dir})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "app.prot")
+}
+
+func TestDump_RedactsSecrets(t *testing.T) {
+	setRequiredEnv(t)
+
+	cfg, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: t.TempDir()})
+	require.NoError(t, err)
+
+	dump := cfg.Dump(true)
+	assert.Equal(t, "****", dump["database"].(map[string]any)["password"])
+	assert.Equal(t, "****", dump["jwt"].(map[string]any)["secret"])
+	assert.Equal(t, "24h0m0s", dump["jwt"].(map[string]any)["expiry"])
+
+	plain := cfg.Dump(false)
+	assert.Equal(t, "test-password", plain["database"].(map[string]any)["password"])
+}
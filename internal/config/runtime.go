@@ -0,0 +1,171 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RuntimeConfig is an immutable snapshot of the settings that can change without a restart.
+// Everything else in Config is read once at startup.
+type RuntimeConfig struct {
+	Version        uint64 // 1 at startup, incremented on every successful reload
+	LoadedAt       time.Time
+	CORS           CORSConfig
+	LogLevel       string
+	RequestTimeout time.Duration
+	RouteTimeouts  map[string]time.Duration // see ServerConfig.RouteTimeouts
+	EnforceTimeout bool
+	Client         ClientConfig
+}
+
+func newRuntimeConfig(cfg *Config, version uint64) *RuntimeConfig {
+	return &RuntimeConfig{
+		Version:        version,
+		LoadedAt:       time.Now(),
+		CORS:           cfg.CORS,
+		LogLevel:       cfg.Log.Level,
+		RequestTimeout: cfg.Server.RequestTimeout,
+		RouteTimeouts:  cfg.Server.RouteTimeouts,
+		EnforceTimeout: cfg.Server.EnforceTimeout,
+		Client:         cfg.Client,
+	}
+}
+
+// TimeoutFor returns the request timeout of a route: "METHOD /pattern" first,
+// then "/pattern" for any method, then RequestTimeout.
+func (rc *RuntimeConfig) TimeoutFor(method, route string) time.Duration {
+	if timeout, ok := lookupRoute(rc.RouteTimeouts, method, route); ok {
+		return timeout
+	}
+	return rc.RequestTimeout
+}
+
+// lookupRoute finds the per-route value for "METHOD /pattern", then "/pattern"
+func lookupRoute[V any](values map[string]V, method, route string) (V, bool) {
+	if v, ok := values[method+" "+route]; ok {
+		return v, true
+	}
+	v, ok := values[route]
+	return v, ok
+}
+
+// validRouteKey reports whether key is "METHOD /pattern" or "/pattern"
+func validRouteKey(key string) bool {
+	_, pattern, ok := splitRouteKey(key)
+	return ok && strings.HasPrefix(pattern, "/")
+}
+
+// splitRouteKey splits a per-route key (e.g. RouteTimeouts) into its optional method and route pattern
+func splitRouteKey(key string) (method, pattern string, ok bool) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "/") {
+		return "", key, true
+	}
+	method, pattern, ok = strings.Cut(key, " ")
+	return method, strings.TrimSpace(pattern), ok && method == strings.ToUpper(method)
+}
+
+// Reloader holds the current RuntimeConfig and swaps it atomically on reload.
+// Middleware reads Current() on each request, so a reload applies to the next request.
+type Reloader struct {
+	opts    LoadOptions
+	startup *Config // settings read once at startup, compared on reload to warn about ignored changes
+	current atomic.Pointer[RuntimeConfig]
+
+	mu        sync.Mutex // serializes reloads and listener registration
+	listeners []func(*RuntimeConfig)
+}
+
+// NewReloader creates a reloader starting from cfg. Reload re-reads every layer using opts.
+func NewReloader(cfg *Config, opts LoadOptions) *Reloader {
+	r := &Reloader{opts: opts, startup: cfg}
+	r.current.Store(newRuntimeConfig(cfg, 1))
+	return r
+}
+
+// Current returns the active snapshot
+func (r *Reloader) Current() *RuntimeConfig {
+	return r.current.Load()
+}
+
+// OnReload registers fn to be called with each new snapshot after a successful reload
+func (r *Reloader) OnReload(fn func(*RuntimeConfig)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.listeners = append(r.listeners, fn)
+}
+
+// Reload re-reads all configuration layers and swaps in a new snapshot.
+// The whole reload is rejected and the current snapshot kept if any value is invalid.
+func (r *Reloader) Reload() (*RuntimeConfig, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, err := LoadWithOptions(r.opts)
+	if err != nil {
+		return nil, fmt.Errorf("설정 재적용 거부: %w", err)
+	}
+
+	if keys := restartRequired(r.startup, cfg); len(keys) > 0 {
+		slog.Warn("재시작이 필요한 설정이 변경되었습니다 - 재시작 전까지 적용되지 않습니다", "keys", keys)
+	}
+
+	prev := r.current.Load()
+	next := newRuntimeConfig(cfg, prev.Version+1)
+	r.current.Store(next)
+
+	for _, fn := range r.listeners {
+		fn(next)
+	}
+
+	slog.Info("설정 재적용 완료",
+		"version", next.Version,
+		"cors_allowed_origins", next.CORS.AllowedOrigins,
+		"log_level", next.LogLevel,
+		"request_timeout", next.RequestTimeout.String(),
+	)
+	return next, nil
+}
+
+// reloadablePaths are the settings newRuntimeConfig takes; a path ending in "." covers a whole section
+var reloadablePaths = []string{
+	"cors.",
+	"log.level",
+	"server.request_timeout",
+	"server.route_timeouts",
+	"server.enforce_timeout",
+	"client.",
+}
+
+// restartRequired returns the paths of the settings that differ between prev and next
+// but only take effect after a restart. Secret values are compared but never logged.
+func restartRequired(prev, next *Config) []string {
+	prevFields := collectFields(reflect.ValueOf(prev).Elem(), "")
+	nextFields := collectFields(reflect.ValueOf(next).Elem(), "")
+
+	var keys []string
+	for i, f := range nextFields {
+		if isReloadable(f.path) {
+			continue
+		}
+		if !reflect.DeepEqual(prevFields[i].value.Interface(), f.value.Interface()) {
+			keys = append(keys, f.path)
+		}
+	}
+	return keys
+}
+
+func isReloadable(path string) bool {
+	for _, p := range reloadablePaths {
+		if path == p || (strings.HasSuffix(p, ".") && strings.HasPrefix(path, p)) {
+			return true
+		}
+	}
+	return false
+}
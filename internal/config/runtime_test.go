@@ -0,0 +1,115 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReloader(t *testing.T, dir string) *config.Reloader {
+	t.Helper()
+
+	opts := config.LoadOptions{Env: "test", ConfigDir: dir}
+	cfg, err := config.LoadWithOptions(opts)
+	require.NoError(t, err)
+
+	return config.NewReloader(cfg, opts)
+}
+
+func TestReloader_AppliesChangedFile(t *testing.T) {
+	// Given
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "server:\n  request_timeout: 10s\n")
+	reloader := newTestReloader(t, dir)
+
+	var notified *config.RuntimeConfig
+	reloader.OnReload(func(rc *config.RuntimeConfig) { notified = rc })
+
+	// When
+	writeFile(t, dir, "config.yaml", "server:\n  request_timeout: 5s\ncors:\n  allowed_origins: [https://app.example]\nlog:\n  level: warn\n")
+	rc, err := reloader.Reload()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), rc.Version)
+	assert.Equal(t, 5*time.Second, rc.RequestTimeout)
+	assert.Equal(t, []string{"https://app.example"}, rc.CORS.AllowedOrigins)
+	assert.Equal(t, "warn", rc.LogLevel)
+	assert.Same(t, rc, reloader.Current())
+	assert.Same(t, rc, notified)
+}
+
+func TestReloader_RejectsInvalidReloadAsWhole(t *testing.T) {
+	// Given
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "server:\n  request_timeout: 10s\n")
+	reloader := newTestReloader(t, dir)
+	before := reloader.Current()
+
+	// When: one valid and one invalid change
+	writeFile(t, dir, "config.yaml", "server:\n  request_timeout: 5s\nlog:\n  level: loud\n")
+	_, err := reloader.Reload()
+
+	// Then: nothing is applied
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "로그 레벨")
+	assert.Same(t, before, reloader.Current())
+	assert.Equal(t, 10*time.Second, reloader.Current().RequestTimeout)
+}
+
+func TestReloader_RejectedReloadKeepsEnvironment(t *testing.T) {
+	// Given: LOG_LEVEL comes from the .env file
+	setRequiredEnv(t)
+	t.Setenv("LOG_LEVEL", "")
+	require.NoError(t, os.Unsetenv("LOG_LEVEL"))
+	dir := t.TempDir()
+	t.Chdir(dir)
+	writeFile(t, dir, ".env.test", "LOG_LEVEL=warn\n")
+	reloader := newTestReloader(t, dir)
+	t.Cleanup(func() {
+		// Forget the file's keys for the following tests
+		writeFile(t, dir, ".env.test", "")
+		_, err := reloader.Reload()
+		require.NoError(t, err)
+	})
+	require.Equal(t, "warn", os.Getenv("LOG_LEVEL"))
+
+	// When: the file changes to an invalid value
+	writeFile(t, dir, ".env.test", "LOG_LEVEL=loud\n")
+	_, err := reloader.Reload()
+
+	// Then: the process environment is untouched
+	require.Error(t, err)
+	assert.Equal(t, "warn", os.Getenv("LOG_LEVEL"))
+	assert.Equal(t, "warn", reloader.Current().LogLevel)
+}
+
+func TestRuntimeConfig_TimeoutFor(t *testing.T) {
+	// Given
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "server:\n  request_timeout: 10s\n  route_timeouts:\n    \"GET /api/v1/reports/export\": 5m\n    /api/v1/uploads: 1m\n")
+	rc := newTestReloader(t, dir).Current()
+
+	// Then: method+route, then route, then global
+	assert.Equal(t, 5*time.Minute, rc.TimeoutFor("GET", "/api/v1/reports/export"))
+	assert.Equal(t, 10*time.Second, rc.TimeoutFor("POST", "/api/v1/reports/export"))
+	assert.Equal(t, time.Minute, rc.TimeoutFor("POST", "/api/v1/uploads"))
+	assert.Equal(t, 10*time.Second, rc.TimeoutFor("GET", "/api/v1/ping"))
+}
+
+func TestRuntimeConfig_TimeoutFor_Env(t *testing.T) {
+	// Given
+	setRequiredEnv(t)
+	t.Setenv("SERVER_ROUTE_TIMEOUTS", "GET /api/v1/reports/export=2m")
+	rc := newTestReloader(t, t.TempDir()).Current()
+
+	// Then
+	assert.Equal(t, 2*time.Minute, rc.TimeoutFor("GET", "/api/v1/reports/export"))
+}
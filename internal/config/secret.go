@@ -0,0 +1,247 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SecretProvider resolves secret references for one URI scheme.
+//
+// Cloud vaults plug in by implementing this interface and calling RegisterSecretProvider
+// before config.Load, e.g. an OCI Vault provider for "ocivault://<secret-ocid>".
+type SecretProvider interface {
+	// Scheme returns the URI scheme handled by this provider (e.g. "file")
+	Scheme() string
+	// Resolve returns the secret value for ref, the part after "<scheme>://"
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+var (
+	secretProvidersMu sync.RWMutex
+	secretProviders   = map[string]SecretProvider{}
+)
+
+func init() {
+	RegisterSecretProvider(FileSecretProvider{})
+	RegisterSecretProvider(EnvSecretProvider{})
+}
+
+// RegisterSecretProvider registers (or replaces) the provider for its scheme
+func RegisterSecretProvider(p SecretProvider) {
+	secretProvidersMu.Lock()
+	defer secretProvidersMu.Unlock()
+
+	secretProviders[p.Scheme()] = p
+}
+
+// parseSecretRef splits "scheme://ref" when scheme has a registered provider
+func parseSecretRef(value string) (SecretProvider, string, bool) {
+	scheme, ref, ok := strings.Cut(value, "://")
+	if !ok {
+		return nil, "", false
+	}
+
+	secretProvidersMu.RLock()
+	defer secretProvidersMu.RUnlock()
+
+	p, ok := secretProviders[scheme]
+	return p, ref, ok
+}
+
+// IsSecretRef reports whether value is a reference handled by a registered provider
+func IsSecretRef(value string) bool {
+	_, _, ok := parseSecretRef(value)
+	return ok
+}
+
+// ResolveSecret resolves a secret reference. Plain values are returned unchanged.
+func ResolveSecret(ctx context.Context, value string) (string, error) {
+	p, ref, ok := parseSecretRef(value)
+	if !ok {
+		return value, nil
+	}
+
+	secret, err := p.Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("secret 조회 실패 (%s://): %w", p.Scheme(), err)
+	}
+	if secret == "" {
+		return "", fmt.Errorf("secret 값이 비어 있습니다 (%s://)", p.Scheme())
+	}
+	return secret, nil
+}
+
+// FileSecretProvider reads secrets from files, e.g. Kubernetes/Docker secrets:
+//
+//	DB_PASSWORD=file:///run/secrets/db_password
+//
+// Trailing newlines are trimmed.
+type FileSecretProvider struct{}
+
+func (FileSecretProvider) Scheme() string {
+	return "file"
+}
+
+func (FileSecretProvider) Resolve(_ context.Context, ref string) (string, error) {
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// EnvSecretProvider reads secrets from another environment variable:
+//
+//	JWT_SECRET=env://PLATFORM_INJECTED_JWT_SECRET
+type EnvSecretProvider struct{}
+
+func (EnvSecretProvider) Scheme() string {
+	return "env"
+}
+
+func (EnvSecretProvider) Resolve(_ context.Context, ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("환경 변수가 없습니다: %s", ref)
+	}
+	return value, nil
+}
+
+// secretStore keeps the references behind secret fields so they can be re-resolved at runtime
+type secretStore struct {
+	mu        sync.Mutex
+	refs      map[string]string // path -> reference
+	values    map[string]string // path -> last resolved value
+	listeners map[string][]func(value string)
+}
+
+func newSecretStore() *secretStore {
+	return &secretStore{
+		refs:      make(map[string]string),
+		values:    make(map[string]string),
+		listeners: make(map[string][]func(value string)),
+	}
+}
+
+// OnSecretChange registers fn to be called with the new value whenever the secret at path
+// (e.g. "jwt.secret", "database.password") rotates. Config fields keep their load-time value,
+// so components that must follow rotation subscribe here.
+func (c *Config) OnSecretChange(path string, fn func(value string)) {
+	if c.secretRefs == nil {
+		return
+	}
+
+	c.secretRefs.mu.Lock()
+	defer c.secretRefs.mu.Unlock()
+
+	c.secretRefs.listeners[path] = append(c.secretRefs.listeners[path], fn)
+}
+
+// RefreshSecrets re-resolves every secret reference and notifies listeners of changed values.
+// A failing reference keeps its previous value.
+func (c *Config) RefreshSecrets(ctx context.Context) error {
+	if c.secretRefs == nil {
+		return nil
+	}
+
+	c.secretRefs.mu.Lock()
+	refs := make(map[string]string, len(c.secretRefs.refs))
+	for path, ref := range c.secretRefs.refs {
+		refs[path] = ref
+	}
+	c.secretRefs.mu.Unlock()
+
+	var errs []error
+	for path, ref := range refs {
+		value, err := ResolveSecret(ctx, ref)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		c.secretRefs.mu.Lock()
+		changed := c.secretRefs.values[path] != value
+		c.secretRefs.values[path] = value
+		listeners := append([]func(string){}, c.secretRefs.listeners[path]...)
+		c.secretRefs.mu.Unlock()
+
+		if changed {
+			slog.Info("Secret 갱신됨", "secret", path, "listeners", len(listeners))
+			for _, fn := range listeners {
+				fn(value)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SecretRefresher periodically calls Config.RefreshSecrets
+type SecretRefresher struct {
+	cfg      *Config
+	interval time.Duration
+	cancel   context.CancelFunc
+	done     chan struct{}
+	once     sync.Once
+}
+
+// NewSecretRefresher creates a refresher using cfg.Secrets.RefreshInterval (0 disables it)
+func NewSecretRefresher(cfg *Config) *SecretRefresher {
+	return &SecretRefresher{
+		cfg:      cfg,
+		interval: cfg.Secrets.RefreshInterval,
+	}
+}
+
+// Start begins periodic refresh in a background goroutine
+func (r *SecretRefresher) Start(ctx context.Context) {
+	if r.interval <= 0 || r.cfg.secretRefs == nil || len(r.cfg.secretRefs.refs) == 0 {
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.cfg.RefreshSecrets(ctx); err != nil {
+					slog.Error("Secret 갱신 실패", "error", err)
+				}
+			}
+		}
+	}()
+
+	slog.Info("Secret 주기 갱신 시작", "interval", r.interval.String())
+}
+
+// Stop stops the refresh loop
+func (r *SecretRefresher) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+
+	var err error
+	r.once.Do(func() {
+		r.cancel()
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
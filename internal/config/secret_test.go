@@ -0,0 +1,61 @@
+package config_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_ResolvesFileSecret(t *testing.T) {
+	// Given: DB_PASSWORD points at a mounted secret file
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "db_password", "from-file\n")
+	t.Setenv("DB_PASSWORD", "file://"+filepath.Join(dir, "db_password"))
+
+	// When
+	cfg, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: t.TempDir()})
+
+	// Then: trailing newline is trimmed
+	require.NoError(t, err)
+	assert.Equal(t, "from-file", cfg.Database.Password)
+}
+
+func TestLoad_MissingSecretFileIsError(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("JWT_SECRET", "file://"+filepath.Join(t.TempDir(), "missing"))
+
+	_, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: t.TempDir()})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "JWT_SECRET")
+}
+
+func TestRefreshSecrets_NotifiesOnRotation(t *testing.T) {
+	// Given
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "jwt_secret")
+	writeFile(t, dir, "jwt_secret", "first-secret-value-that-is-at-least-32-chars")
+	t.Setenv("JWT_SECRET", "file://"+secretFile)
+
+	cfg, err := config.LoadWithOptions(config.LoadOptions{Env: "test", ConfigDir: t.TempDir()})
+	require.NoError(t, err)
+
+	var rotated []string
+	cfg.OnSecretChange("jwt.secret", func(value string) {
+		rotated = append(rotated, value)
+	})
+
+	// When: unchanged refresh, then the file is rotated
+	require.NoError(t, cfg.RefreshSecrets(context.Background()))
+	writeFile(t, dir, "jwt_secret", "second-secret-value-that-is-at-least-32-chars")
+	require.NoError(t, cfg.RefreshSecrets(context.Background()))
+
+	// Then: listener fires once with the new value
+	assert.Equal(t, []string{"second-secret-value-that-is-at-least-32-chars"}, rotated)
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Watcher polls the .env and config files and triggers Reloader.Reload when one changes.
+// Polling (instead of inotify) also works for ConfigMap volumes whose files are swapped via symlinks.
+type Watcher struct {
+	reloader *Reloader
+	interval time.Duration
+	files    []string
+	cancel   context.CancelFunc
+	done     chan struct{}
+	once     sync.Once
+}
+
+// fileState identifies a file version; the zero value means the file does not exist
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewWatcher creates a watcher for the files reloader loads from (interval 0 disables it)
+func NewWatcher(reloader *Reloader, interval time.Duration) *Watcher {
+	opts := reloader.opts
+	dir := configDir(opts.ConfigDir)
+
+	files := []string{".env." + opts.Env}
+	for _, name := range []string{"config", "config." + opts.Env} {
+		for _, ext := range []string{".yaml", ".yml", ".toml"} {
+			files = append(files, filepath.Join(dir, name+ext))
+		}
+	}
+
+	return &Watcher{
+		reloader: reloader,
+		interval: interval,
+		files:    files,
+	}
+}
+
+// Start begins polling in a background goroutine
+func (w *Watcher) Start(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+	last := w.stat()
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				current := w.stat()
+				if !changed(last, current) {
+					continue
+				}
+				last = current
+
+				slog.Info("설정 파일 변경 감지")
+				if _, err := w.reloader.Reload(); err != nil {
+					slog.Error("설정 재적용 실패", "error", err)
+				}
+			}
+		}
+	}()
+
+	slog.Info("설정 파일 감시 시작", "interval", w.interval.String())
+}
+
+// Stop stops polling
+func (w *Watcher) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+
+	var err error
+	w.once.Do(func() {
+		w.cancel()
+		select {
+		case <-w.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+func (w *Watcher) stat() map[string]fileState {
+	states := make(map[string]fileState, len(w.files))
+	for _, path := range w.files {
+		// Stat follows symlinks, so a swapped ConfigMap target shows up as a change
+		if info, err := os.Stat(path); err == nil {
+			states[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return states
+}
+
+func changed(prev, current map[string]fileState) bool {
+	if len(prev) != len(current) {
+		return true
+	}
+	for path, state := range current {
+		if prev[path] != state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,369 @@
+package feature_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/feature"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// testMemberHeader stands in for JWT: its value is set as the authenticated member ID
+const testMemberHeader = "X-Test-Member"
+
+// setupTestRouter registers the admin routes and sample member routes behind Maintenance and Require (APP_ENV=prod)
+func setupTestRouter(t *testing.T) (*gin.Engine, *feature.FeatureService) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	cfg := testutil.NewTestConfig()
+	featureService := feature.NewFeatureService(db, feature.NewFeatureRepository(), "prod", config.FeatureConfig{RetryAfter: 5 * time.Minute})
+	featureHandler := feature.NewFeatureHandler(featureService)
+
+	router := testutil.SetupTestRouter()
+	admin := router.Group("/api/v1/admin", middleware.InternalOnly(cfg.Internal.Token))
+	admin.GET("/flags", handler.Handle(featureHandler.ListFlags))
+	admin.PUT("/flags/:key", handler.Handle(featureHandler.SaveFlag))
+	admin.DELETE("/flags/:key", handler.Handle(featureHandler.DeleteFlag))
+	admin.PUT("/maintenance/:scope", handler.Handle(featureHandler.SetMaintenance))
+	admin.DELETE("/maintenance/:scope", handler.Handle(featureHandler.ClearMaintenance))
+
+	authenticate := func(c *gin.Context) {
+		if id := c.GetHeader(testMemberHeader); id != "" {
+			c.Set(sharedContext.MemberIDKey, id)
+		}
+		c.Next()
+	}
+	members := router.Group("/api/v1/members", feature.Maintenance(featureService, "members"), authenticate)
+	members.GET("/me", func(c *gin.Context) { c.Status(http.StatusOK) })
+	members.POST("/me", func(c *gin.Context) { c.Status(http.StatusOK) })
+	members.GET("/me/features", handler.Handle(featureHandler.GetMyFeatures))
+	members.GET("/rooms", feature.Require(featureService, "rooms"), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	auth := router.Group("/api/v1/auth", feature.Maintenance(featureService, "auth", "POST /api/v1/auth/login"))
+	auth.POST("/login", func(c *gin.Context) { c.Status(http.StatusOK) })
+	auth.POST("/signup", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	return router, featureService
+}
+
+func adminRequest(method, url string, body any) testutil.TestRequest {
+	return testutil.TestRequest{
+		Method:  method,
+		URL:     url,
+		Body:    body,
+		Headers: map[string]string{middleware.InternalTokenHeader: testutil.NewTestConfig().Internal.Token},
+	}
+}
+
+func memberRequest(method, url string, memberID uint32) testutil.TestRequest {
+	return testutil.TestRequest{
+		Method:  method,
+		URL:     url,
+		Headers: map[string]string{testMemberHeader: strconv.FormatUint(uint64(memberID), 10)},
+	}
+}
+
+func TestAdmin_RequiresInternalToken(t *testing.T) {
+	// Given
+	router, _ := setupTestRouter(t)
+
+	for _, token := range []string{"", "wrong-token"} {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodGet,
+			URL:     "/api/v1/admin/flags",
+			Headers: map[string]string{middleware.InternalTokenHeader: token},
+		})
+
+		// Then
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		var errorResponse sharedError.ErrorResponse
+		testutil.ParseResponse(t, recorder, &errorResponse)
+		assert.Equal(t, "ERROR-013", errorResponse.Code)
+	}
+}
+
+func TestAdmin_Flags(t *testing.T) {
+	// Given
+	router, _ := setupTestRouter(t)
+
+	// When: create, then replace
+	recorder := testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/flags/rooms", feature.FlagRequest{
+		Description: "기도방",
+		Enabled:     true,
+		Percentage:  10,
+	}))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	recorder = testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/flags/rooms", feature.FlagRequest{
+		Enabled:        true,
+		Environments:   []string{"dev", "prod"},
+		Percentage:     50,
+		AllowMemberIDs: []uint32{7},
+	}))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// Then
+	recorder = testutil.ExecuteRequest(t, router, adminRequest(http.MethodGet, "/api/v1/admin/flags", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var flags []feature.FlagResponse
+	testutil.ParseResponse(t, recorder, &flags)
+	require.Len(t, flags, 1)
+	assert.Equal(t, "rooms", flags[0].Key)
+	assert.Equal(t, []string{"dev", "prod"}, flags[0].Environments)
+	assert.Equal(t, 50, flags[0].Percentage)
+	assert.Equal(t, []uint32{7}, flags[0].AllowMemberIDs)
+
+	// When: delete twice
+	recorder = testutil.ExecuteRequest(t, router, adminRequest(http.MethodDelete, "/api/v1/admin/flags/rooms", nil))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	recorder = testutil.ExecuteRequest(t, router, adminRequest(http.MethodDelete, "/api/v1/admin/flags/rooms", nil))
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	var errorResponse sharedError.ErrorResponse
+	testutil.ParseResponse(t, recorder, &errorResponse)
+	assert.Equal(t, "FEATURE-001", errorResponse.Code)
+}
+
+func TestAdmin_InvalidRequests(t *testing.T) {
+	// Given
+	router, _ := setupTestRouter(t)
+
+	testCases := []struct {
+		name         string
+		request      testutil.TestRequest
+		expectedCode string
+	}{
+		{
+			name:         "Invalid flag key",
+			request:      adminRequest(http.MethodPut, "/api/v1/admin/flags/Rooms!", feature.FlagRequest{Enabled: true}),
+			expectedCode: "FEATURE-003",
+		},
+		{
+			name:         "Percentage over 100",
+			request:      adminRequest(http.MethodPut, "/api/v1/admin/flags/rooms", feature.FlagRequest{Percentage: 101}),
+			expectedCode: "ERROR-001",
+		},
+		{
+			name:         "Unknown maintenance mode",
+			request:      adminRequest(http.MethodPut, "/api/v1/admin/maintenance/members", map[string]string{"mode": "closed"}),
+			expectedCode: "ERROR-001",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, router, tc.request)
+
+			// Then
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			var errorResponse sharedError.ErrorResponse
+			testutil.ParseResponse(t, recorder, &errorResponse)
+			assert.Equal(t, tc.expectedCode, errorResponse.Code)
+		})
+	}
+}
+
+func TestIsEnabled_Rules(t *testing.T) {
+	// Given
+	router, featureService := setupTestRouter(t)
+	save := func(key string, request feature.FlagRequest) {
+		recorder := testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/flags/"+key, request))
+		require.Equal(t, http.StatusOK, recorder.Code)
+	}
+	save("everyone", feature.FlagRequest{Enabled: true, Percentage: 100})
+	save("disabled", feature.FlagRequest{Enabled: false, Percentage: 100, AllowMemberIDs: []uint32{1}})
+	save("dev-only", feature.FlagRequest{Enabled: true, Percentage: 100, Environments: []string{"dev"}})
+	save("beta", feature.FlagRequest{Enabled: true, Percentage: 0, AllowMemberIDs: []uint32{1}})
+	ctx := t.Context()
+
+	// Then
+	assert.True(t, featureService.IsEnabled(ctx, "everyone", 0))
+	assert.False(t, featureService.IsEnabled(ctx, "disabled", 1))
+	assert.False(t, featureService.IsEnabled(ctx, "dev-only", 1))
+	assert.True(t, featureService.IsEnabled(ctx, "beta", 1))
+	assert.False(t, featureService.IsEnabled(ctx, "beta", 2))
+	assert.False(t, featureService.IsEnabled(ctx, "unknown", 1))
+}
+
+func TestIsEnabled_BacksOffAfterFailedLoad(t *testing.T) {
+	// Given: a cached service whose flags table is gone
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	featureService := feature.NewFeatureService(db, feature.NewFeatureRepository(), "prod", config.FeatureConfig{CacheTTL: time.Minute})
+	require.NoError(t, db.Migrator().DropTable(&model.FeatureFlag{}))
+
+	queries := 0
+	require.NoError(t, db.Callback().Query().Before("gorm:query").Register("test:count", func(*gorm.DB) { queries++ }))
+
+	// When
+	first := featureService.IsEnabled(t.Context(), "rooms", 1)
+	second := featureService.IsEnabled(t.Context(), "rooms", 1)
+
+	// Then: the failure is kept for the TTL instead of querying on every call
+	assert.False(t, first)
+	assert.False(t, second)
+	assert.Equal(t, 1, queries)
+}
+
+func TestIsEnabled_PercentageIsStable(t *testing.T) {
+	// Given
+	router, featureService := setupTestRouter(t)
+	rollout := func(percentage int) map[uint32]bool {
+		recorder := testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/flags/rooms",
+			feature.FlagRequest{Enabled: true, Percentage: percentage}))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		enabled := map[uint32]bool{}
+		for id := uint32(1); id <= 1000; id++ {
+			if featureService.IsEnabled(t.Context(), "rooms", id) {
+				enabled[id] = true
+			}
+		}
+		return enabled
+	}
+
+	// When
+	at20 := rollout(20)
+	at50 := rollout(50)
+
+	// Then: roughly the requested share, and members already in stay in
+	assert.InDelta(t, 200, len(at20), 60)
+	assert.InDelta(t, 500, len(at50), 80)
+	for id := range at20 {
+		assert.True(t, at50[id], "member %d dropped out when the rollout grew", id)
+	}
+	assert.False(t, featureService.IsEnabled(t.Context(), "rooms", 0), "anonymous requests only see flags at 100%")
+}
+
+func TestMyFeatures_AndRequire(t *testing.T) {
+	// Given: rooms is on for member 7 only
+	router, _ := setupTestRouter(t)
+	recorder := testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/flags/rooms",
+		feature.FlagRequest{Enabled: true, AllowMemberIDs: []uint32{7}}))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// When
+	allowed := testutil.ExecuteRequest(t, router, memberRequest(http.MethodGet, "/api/v1/members/rooms", 7))
+	hidden := testutil.ExecuteRequest(t, router, memberRequest(http.MethodGet, "/api/v1/members/rooms", 8))
+	features := testutil.ExecuteRequest(t, router, memberRequest(http.MethodGet, "/api/v1/members/me/features", 7))
+
+	// Then
+	assert.Equal(t, http.StatusOK, allowed.Code)
+	assert.Equal(t, http.StatusNotFound, hidden.Code)
+	var errorResponse sharedError.ErrorResponse
+	testutil.ParseResponse(t, hidden, &errorResponse)
+	assert.Equal(t, "ERROR-014", errorResponse.Code)
+
+	require.Equal(t, http.StatusOK, features.Code)
+	var response feature.FeaturesResponse
+	testutil.ParseResponse(t, features, &response)
+	assert.Equal(t, []string{"rooms"}, response.Features)
+}
+
+func TestMaintenance_ReadOnly(t *testing.T) {
+	// Given
+	router, _ := setupTestRouter(t)
+	recorder := testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/maintenance/members",
+		feature.MaintenanceRequest{Mode: model.MaintenanceModeReadOnly, Reason: "DB 마이그레이션"}))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// When
+	read := testutil.ExecuteRequest(t, router, memberRequest(http.MethodGet, "/api/v1/members/me", 1))
+	write := testutil.ExecuteRequest(t, router, memberRequest(http.MethodPost, "/api/v1/members/me", 1))
+
+	// Then
+	assert.Equal(t, http.StatusOK, read.Code)
+	assert.Equal(t, http.StatusServiceUnavailable, write.Code)
+	assert.Equal(t, "300", write.Header().Get("Retry-After"))
+	var errorResponse sharedError.ErrorResponse
+	testutil.ParseResponse(t, write, &errorResponse)
+	assert.Equal(t, "ERROR-012", errorResponse.Code)
+
+	// When: cleared
+	recorder = testutil.ExecuteRequest(t, router, adminRequest(http.MethodDelete, "/api/v1/admin/maintenance/members", nil))
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	write = testutil.ExecuteRequest(t, router, memberRequest(http.MethodPost, "/api/v1/members/me", 1))
+
+	// Then
+	assert.Equal(t, http.StatusOK, write.Code)
+}
+
+func TestMaintenance_ReadOnlyAllowsListedRoutes(t *testing.T) {
+	// Given
+	router, _ := setupTestRouter(t)
+	recorder := testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/maintenance/auth",
+		feature.MaintenanceRequest{Mode: model.MaintenanceModeReadOnly}))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// When
+	login := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodPost, URL: "/api/v1/auth/login"})
+	signup := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodPost, URL: "/api/v1/auth/signup"})
+
+	// Then
+	assert.Equal(t, http.StatusOK, login.Code)
+	assert.Equal(t, http.StatusServiceUnavailable, signup.Code)
+}
+
+func TestMaintenance_AllScopesUntilEndsAt(t *testing.T) {
+	// Given: maintenance on every group for 10 more minutes, and an expired window on members
+	router, _ := setupTestRouter(t)
+	endsAt := time.Now().Add(10 * time.Minute)
+	expired := time.Now().Add(-time.Minute)
+	for scope, request := range map[string]feature.MaintenanceRequest{
+		feature.ScopeAll: {Mode: model.MaintenanceModeMaintenance, EndsAt: &endsAt},
+		"members":        {Mode: model.MaintenanceModeMaintenance, EndsAt: &expired},
+	} {
+		recorder := testutil.ExecuteRequest(t, router, adminRequest(http.MethodPut, "/api/v1/admin/maintenance/"+scope, request))
+		require.Equal(t, http.StatusOK, recorder.Code, scope)
+	}
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, memberRequest(http.MethodGet, "/api/v1/members/me", 1))
+
+	// Then
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	retryAfter, err := strconv.Atoi(recorder.Header().Get("Retry-After"))
+	require.NoError(t, err)
+	assert.InDelta(t, 600, retryAfter, 5)
+
+	var errorResponse struct {
+		Code    string `json:"code"`
+		Details struct {
+			Mode   string    `json:"mode"`
+			EndsAt time.Time `json:"endsAt"`
+		} `json:"details"`
+	}
+	testutil.ParseResponse(t, recorder, &errorResponse)
+	assert.Equal(t, "ERROR-011", errorResponse.Code)
+	assert.Equal(t, model.MaintenanceModeMaintenance, errorResponse.Details.Mode)
+	assert.WithinDuration(t, endsAt, errorResponse.Details.EndsAt, time.Second)
+
+	// When: the window on every group is cleared, the expired one on members no longer applies
+	recorder = testutil.ExecuteRequest(t, router, adminRequest(http.MethodDelete, "/api/v1/admin/maintenance/*", nil))
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	recorder = testutil.ExecuteRequest(t, router, memberRequest(http.MethodGet, "/api/v1/members/me", 1))
+
+	// Then
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -0,0 +1,42 @@
+package feature
+
+import "time"
+
+// FlagRequest creates or replaces a feature flag
+type FlagRequest struct {
+	Description    string   `json:"description" binding:"max=500"`
+	Enabled        bool     `json:"enabled"`
+	Environments   []string `json:"environments" binding:"omitempty,dive,required,max=20"` // 빈 값: 전체 환경
+	Percentage     int      `json:"percentage" binding:"min=0,max=100"`
+	AllowMemberIDs []uint32 `json:"allowMemberIds"`
+}
+
+type FlagResponse struct {
+	Key            string    `json:"key"`
+	Description    string    `json:"description"`
+	Enabled        bool      `json:"enabled"`
+	Environments   []string  `json:"environments"`
+	Percentage     int       `json:"percentage"`
+	AllowMemberIDs []uint32  `json:"allowMemberIds"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// MaintenanceRequest puts a route group into maintenance or read-only mode
+type MaintenanceRequest struct {
+	Mode   string     `json:"mode" binding:"required,oneof=maintenance read_only"`
+	Reason string     `json:"reason" binding:"max=500"`
+	EndsAt *time.Time `json:"endsAt"` // 없으면 수동 해제
+}
+
+type MaintenanceResponse struct {
+	Scope     string     `json:"scope"`
+	Mode      string     `json:"mode"`
+	Reason    string     `json:"reason"`
+	EndsAt    *time.Time `json:"endsAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// FeaturesResponse lists the flags enabled for the member
+type FeaturesResponse struct {
+	Features []string `json:"features"`
+}
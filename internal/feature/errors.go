@@ -0,0 +1,39 @@
+package feature
+
+import (
+	"net/http"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+)
+
+const (
+	flagNotFound        = "FEATURE_FLAG_NOT_FOUND"        // errInfo
+	maintenanceNotFound = "FEATURE_MAINTENANCE_NOT_FOUND" // errInfo
+	invalidName         = "FEATURE_INVALID_NAME"          // errInfo
+)
+
+var (
+	ErrFlagNotFound        = sharedError.NewDomainError(flagNotFound)
+	ErrMaintenanceNotFound = sharedError.NewDomainError(maintenanceNotFound)
+	ErrInvalidName         = sharedError.NewDomainError(invalidName)
+)
+
+func init() {
+	sharedError.RegisterDomainErrorResponse(flagNotFound, sharedError.ErrorResponse{
+		Status:     http.StatusNotFound,
+		Code:       "FEATURE-001",
+		MessageKey: "feature.flag_not_found",
+	})
+
+	sharedError.RegisterDomainErrorResponse(maintenanceNotFound, sharedError.ErrorResponse{
+		Status:     http.StatusNotFound,
+		Code:       "FEATURE-002",
+		MessageKey: "feature.maintenance_not_found",
+	})
+
+	sharedError.RegisterDomainErrorResponse(invalidName, sharedError.ErrorResponse{
+		Status:     http.StatusBadRequest,
+		Code:       "FEATURE-003",
+		MessageKey: "feature.invalid_name",
+	})
+}
@@ -0,0 +1,67 @@
+package feature
+
+import (
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/gin-gonic/gin"
+)
+
+type FeatureHandler struct {
+	featureService *FeatureService
+}
+
+func NewFeatureHandler(featureService *FeatureService) *FeatureHandler {
+	return &FeatureHandler{
+		featureService: featureService,
+	}
+}
+
+// GetMyFeatures returns the flags enabled for the authenticated member
+func (h *FeatureHandler) GetMyFeatures(c *gin.Context) (any, error) {
+	memberID, err := sharedContext.MemberID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.featureService.EnabledFeatures(c.Request.Context(), memberID)
+}
+
+func (h *FeatureHandler) ListFlags(c *gin.Context) (any, error) {
+	return h.featureService.ListFlags(c.Request.Context())
+}
+
+func (h *FeatureHandler) SaveFlag(c *gin.Context) (any, error) {
+	var request FlagRequest
+	if err := handler.ShouldBindJSON(c, &request); err != nil {
+		return nil, err
+	}
+
+	return h.featureService.SaveFlag(c.Request.Context(), c.Param("key"), &request)
+}
+
+func (h *FeatureHandler) DeleteFlag(c *gin.Context) (any, error) {
+	if err := h.featureService.DeleteFlag(c.Request.Context(), c.Param("key")); err != nil {
+		return nil, err
+	}
+	return handler.NoContent(), nil
+}
+
+func (h *FeatureHandler) ListMaintenance(c *gin.Context) (any, error) {
+	return h.featureService.ListMaintenance(c.Request.Context())
+}
+
+func (h *FeatureHandler) SetMaintenance(c *gin.Context) (any, error) {
+	var request MaintenanceRequest
+	if err := handler.ShouldBindJSON(c, &request); err != nil {
+		return nil, err
+	}
+
+	return h.featureService.SetMaintenance(c.Request.Context(), c.Param("scope"), &request)
+}
+
+func (h *FeatureHandler) ClearMaintenance(c *gin.Context) (any, error) {
+	if err := h.featureService.ClearMaintenance(c.Request.Context(), c.Param("scope")); err != nil {
+		return nil, err
+	}
+	return handler.NoContent(), nil
+}
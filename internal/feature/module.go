@@ -0,0 +1,31 @@
+package feature
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/route"
+	"gorm.io/gorm"
+)
+
+// Module provides the feature flags, maintenance windows and their admin endpoints
+var Module = di.Module{
+	Name: "feature",
+	Providers: []any{
+		NewFeatureRepository,
+		func(db *gorm.DB, featureRepository *FeatureRepository, cfg *config.Config) *FeatureService {
+			return NewFeatureService(db, featureRepository, cfg.App.Env, cfg.Feature)
+		},
+		NewFeatureHandler,
+	},
+	Routes: func(api *route.API, h *FeatureHandler) {
+		api.Members.GET("/me/features", handler.Handle(h.GetMyFeatures))
+
+		api.Admin.GET("/flags", handler.Handle(h.ListFlags))
+		api.Admin.PUT("/flags/:key", handler.Handle(h.SaveFlag))
+		api.Admin.DELETE("/flags/:key", handler.Handle(h.DeleteFlag))
+		api.Admin.GET("/maintenance", handler.Handle(h.ListMaintenance))
+		api.Admin.PUT("/maintenance/:scope", handler.Handle(h.SetMaintenance))
+		api.Admin.DELETE("/maintenance/:scope", handler.Handle(h.ClearMaintenance))
+	},
+}
@@ -0,0 +1,66 @@
+package feature
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"gorm.io/gorm"
+)
+
+type FeatureRepository struct{}
+
+func NewFeatureRepository() *FeatureRepository {
+	return &FeatureRepository{}
+}
+
+func (r *FeatureRepository) FindAllFlags(ctx context.Context, db *gorm.DB) ([]model.FeatureFlag, error) {
+	var flags []model.FeatureFlag
+	err := db.WithContext(ctx).Order("flag_key").Find(&flags).Error
+	return flags, err
+}
+
+func (r *FeatureRepository) FindFlagByKey(ctx context.Context, db *gorm.DB, key string) (*model.FeatureFlag, error) {
+	var flag model.FeatureFlag
+	err := db.WithContext(ctx).Where("flag_key = ?", key).First(&flag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+// SaveFlag inserts flag, or updates every column when it has an ID
+func (r *FeatureRepository) SaveFlag(ctx context.Context, db *gorm.DB, flag *model.FeatureFlag) error {
+	return db.WithContext(ctx).Save(flag).Error
+}
+
+// DeleteFlag deletes the flag and reports whether it existed
+func (r *FeatureRepository) DeleteFlag(ctx context.Context, db *gorm.DB, key string) (bool, error) {
+	result := db.WithContext(ctx).Where("flag_key = ?", key).Delete(&model.FeatureFlag{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *FeatureRepository) FindAllMaintenance(ctx context.Context, db *gorm.DB) ([]model.MaintenanceWindow, error) {
+	var windows []model.MaintenanceWindow
+	err := db.WithContext(ctx).Order("scope").Find(&windows).Error
+	return windows, err
+}
+
+func (r *FeatureRepository) FindMaintenanceByScope(ctx context.Context, db *gorm.DB, scope string) (*model.MaintenanceWindow, error) {
+	var window model.MaintenanceWindow
+	err := db.WithContext(ctx).Where("scope = ?", scope).First(&window).Error
+	if err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// SaveMaintenance inserts window, or updates every column when it has an ID
+func (r *FeatureRepository) SaveMaintenance(ctx context.Context, db *gorm.DB, window *model.MaintenanceWindow) error {
+	return db.WithContext(ctx).Save(window).Error
+}
+
+// DeleteMaintenance deletes the window of scope and reports whether it existed
+func (r *FeatureRepository) DeleteMaintenance(ctx context.Context, db *gorm.DB, scope string) (bool, error) {
+	result := db.WithContext(ctx).Where("scope = ?", scope).Delete(&model.MaintenanceWindow{})
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,422 @@
+package feature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"gorm.io/gorm"
+)
+
+// ScopeAll is the maintenance scope covering every route group behind Maintenance
+const ScopeAll = "*"
+
+// namePattern restricts flag keys and maintenance scopes
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,99}$`)
+
+type FeatureService struct {
+	db                *gorm.DB
+	featureRepository *FeatureRepository
+	env               string
+	cacheTTL          time.Duration
+	retryAfter        time.Duration
+
+	// flags and maintenance windows, reloaded after cacheTTL so changes reach every instance.
+	// A failed reload is also kept for cacheTTL, so a database outage costs one query per TTL.
+	mu         sync.Mutex
+	cached     *snapshot
+	loadErr    error         // last reload error, returned while loadedAt is fresh and nothing is cached
+	loadedAt   time.Time     // time of the last reload attempt, successful or not
+	loading    chan struct{} // closed when the running reload finishes; nil if none
+	generation uint64        // incremented by invalidate, so a reload that raced a change is not kept fresh
+}
+
+type snapshot struct {
+	flags   map[string]model.FeatureFlag
+	windows []model.MaintenanceWindow
+}
+
+// NewFeatureService evaluates flags for env (APP_ENV)
+func NewFeatureService(db *gorm.DB, featureRepository *FeatureRepository, env string, cfg config.FeatureConfig) *FeatureService {
+	return &FeatureService{
+		db:                db,
+		featureRepository: featureRepository,
+		env:               env,
+		cacheTTL:          cfg.CacheTTL,
+		retryAfter:        cfg.RetryAfter,
+	}
+}
+
+// IsEnabled reports whether the flag is on for the member (0: anonymous).
+// Unknown flags are off, and so is every flag when they cannot be loaded.
+//
+// Evaluation order:
+//   - disabled flag, or APP_ENV not in its environments: off
+//   - member in the allowlist: on
+//   - otherwise the member's stable bucket (0-99) must be below the percentage;
+//     anonymous requests only see flags at 100%
+func (s *FeatureService) IsEnabled(ctx context.Context, key string, memberID uint32) bool {
+	current, err := s.snapshot(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Warn("기능 플래그 조회 실패 - 꺼진 것으로 처리", "key", key, "error", err)
+		return false
+	}
+
+	flag, ok := current.flags[key]
+	return ok && s.evaluate(&flag, memberID)
+}
+
+// EnabledFeatures returns the keys of the flags on for the member
+func (s *FeatureService) EnabledFeatures(ctx context.Context, memberID uint32) (*FeaturesResponse, error) {
+	current, err := s.snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FeaturesResponse{Features: []string{}}
+	for key, flag := range current.flags {
+		if s.evaluate(&flag, memberID) {
+			response.Features = append(response.Features, key)
+		}
+	}
+	slices.Sort(response.Features)
+	return response, nil
+}
+
+// ActiveMaintenance returns the maintenance window in effect for scope.
+// A window on ScopeAll applies to every scope, and maintenance wins over read-only.
+// When the windows cannot be loaded the API stays available.
+func (s *FeatureService) ActiveMaintenance(ctx context.Context, scope string) (*model.MaintenanceWindow, bool) {
+	current, err := s.snapshot(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Warn("점검 모드 조회 실패 - 점검 모드 미적용", "scope", scope, "error", err)
+		return nil, false
+	}
+
+	now := time.Now()
+	var active *model.MaintenanceWindow
+	for i := range current.windows {
+		window := &current.windows[i]
+		if window.Scope != scope && window.Scope != ScopeAll {
+			continue
+		}
+		if window.EndsAt != nil && !now.Before(*window.EndsAt) {
+			continue
+		}
+		if active == nil || window.Mode == model.MaintenanceModeMaintenance {
+			active = window
+		}
+	}
+	return active, active != nil
+}
+
+// RetryAfter returns how long clients should wait before retrying during window
+func (s *FeatureService) RetryAfter(window *model.MaintenanceWindow) time.Duration {
+	if window.EndsAt == nil {
+		return s.retryAfter
+	}
+	return max(time.Until(*window.EndsAt), time.Second)
+}
+
+func (s *FeatureService) ListFlags(ctx context.Context) ([]FlagResponse, error) {
+	flags, err := s.featureRepository.FindAllFlags(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("기능 플래그 목록 조회 실패: %w", err)
+	}
+
+	responses := make([]FlagResponse, 0, len(flags))
+	for i := range flags {
+		responses = append(responses, toFlagResponse(&flags[i]))
+	}
+	return responses, nil
+}
+
+// SaveFlag creates the flag or replaces its settings
+func (s *FeatureService) SaveFlag(ctx context.Context, key string, request *FlagRequest) (*FlagResponse, error) {
+	if !namePattern.MatchString(key) {
+		return nil, fmt.Errorf("잘못된 기능 플래그 이름: key=%s %w", key, ErrInvalidName)
+	}
+
+	flag, err := s.featureRepository.FindFlagByKey(ctx, s.db, key)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("기능 플래그 조회 실패: key=%s %w", key, err)
+		}
+		flag = &model.FeatureFlag{Key: key}
+	}
+
+	flag.Description = request.Description
+	flag.Enabled = request.Enabled
+	flag.Environments = strings.Join(request.Environments, ",")
+	flag.Percentage = request.Percentage
+	flag.AllowMemberIDs = joinIDs(request.AllowMemberIDs)
+
+	if err := s.featureRepository.SaveFlag(ctx, s.db, flag); err != nil {
+		return nil, fmt.Errorf("기능 플래그 저장 실패: key=%s %w", key, err)
+	}
+	s.invalidate()
+
+	logger.FromContext(ctx).Info("기능 플래그 변경",
+		"key", key,
+		"enabled", flag.Enabled,
+		"environments", flag.Environments,
+		"percentage", flag.Percentage,
+		"allowlist_size", len(request.AllowMemberIDs),
+	)
+	response := toFlagResponse(flag)
+	return &response, nil
+}
+
+func (s *FeatureService) DeleteFlag(ctx context.Context, key string) error {
+	deleted, err := s.featureRepository.DeleteFlag(ctx, s.db, key)
+	if err != nil {
+		return fmt.Errorf("기능 플래그 삭제 실패: key=%s %w", key, err)
+	}
+	if !deleted {
+		return fmt.Errorf("기능 플래그가 없습니다: key=%s %w", key, ErrFlagNotFound)
+	}
+	s.invalidate()
+
+	logger.FromContext(ctx).Info("기능 플래그 삭제", "key", key)
+	return nil
+}
+
+func (s *FeatureService) ListMaintenance(ctx context.Context) ([]MaintenanceResponse, error) {
+	windows, err := s.featureRepository.FindAllMaintenance(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("점검 모드 목록 조회 실패: %w", err)
+	}
+
+	responses := make([]MaintenanceResponse, 0, len(windows))
+	for i := range windows {
+		responses = append(responses, toMaintenanceResponse(&windows[i]))
+	}
+	return responses, nil
+}
+
+// SetMaintenance puts scope (a route group name, or * for all) into the requested mode
+func (s *FeatureService) SetMaintenance(ctx context.Context, scope string, request *MaintenanceRequest) (*MaintenanceResponse, error) {
+	if scope != ScopeAll && !namePattern.MatchString(scope) {
+		return nil, fmt.Errorf("잘못된 점검 범위: scope=%s %w", scope, ErrInvalidName)
+	}
+
+	window, err := s.featureRepository.FindMaintenanceByScope(ctx, s.db, scope)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("점검 모드 조회 실패: scope=%s %w", scope, err)
+		}
+		window = &model.MaintenanceWindow{Scope: scope}
+	}
+
+	window.Mode = request.Mode
+	window.Reason = request.Reason
+	window.EndsAt = request.EndsAt
+
+	if err := s.featureRepository.SaveMaintenance(ctx, s.db, window); err != nil {
+		return nil, fmt.Errorf("점검 모드 저장 실패: scope=%s %w", scope, err)
+	}
+	s.invalidate()
+
+	logger.FromContext(ctx).Warn("점검 모드 설정", "scope", scope, "mode", window.Mode, "ends_at", window.EndsAt, "reason", window.Reason)
+	response := toMaintenanceResponse(window)
+	return &response, nil
+}
+
+func (s *FeatureService) ClearMaintenance(ctx context.Context, scope string) error {
+	deleted, err := s.featureRepository.DeleteMaintenance(ctx, s.db, scope)
+	if err != nil {
+		return fmt.Errorf("점검 모드 해제 실패: scope=%s %w", scope, err)
+	}
+	if !deleted {
+		return fmt.Errorf("점검 모드가 없습니다: scope=%s %w", scope, ErrMaintenanceNotFound)
+	}
+	s.invalidate()
+
+	logger.FromContext(ctx).Warn("점검 모드 해제", "scope", scope)
+	return nil
+}
+
+// evaluate applies the rules documented on IsEnabled
+func (s *FeatureService) evaluate(flag *model.FeatureFlag, memberID uint32) bool {
+	if !flag.Enabled {
+		return false
+	}
+	if flag.Environments != "" && !slices.Contains(splitList(flag.Environments), s.env) {
+		return false
+	}
+	if memberID != 0 && slices.Contains(splitList(flag.AllowMemberIDs), strconv.FormatUint(uint64(memberID), 10)) {
+		return true
+	}
+	if flag.Percentage >= 100 {
+		return true
+	}
+	if memberID == 0 || flag.Percentage <= 0 {
+		return false
+	}
+	return bucket(flag.Key, memberID) < flag.Percentage
+}
+
+// bucket places the member in 0-99 per flag, so raising the percentage keeps members already in
+// and different flags do not select the same members
+func bucket(key string, memberID uint32) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key + ":" + strconv.FormatUint(uint64(memberID), 10)))
+	return int(h.Sum32() % 100)
+}
+
+// snapshot returns the cached flags and maintenance windows, reloading them after cacheTTL.
+// A failed reload keeps serving the previous snapshot and is not retried before cacheTTL.
+func (s *FeatureService) snapshot(ctx context.Context) (*snapshot, error) {
+	s.mu.Lock()
+	if time.Since(s.loadedAt) < s.cacheTTL && (s.cached != nil || s.loadErr != nil) {
+		defer s.mu.Unlock()
+		return s.current()
+	}
+
+	// Only one request reloads; the others keep using the previous snapshot,
+	// or wait for the reload when there is none yet.
+	loading := s.loading
+	if loading == nil {
+		loading = make(chan struct{})
+		s.loading = loading
+		generation := s.generation
+		s.mu.Unlock()
+
+		s.reload(ctx, loading, generation)
+	} else {
+		cached := s.cached
+		s.mu.Unlock()
+
+		if cached != nil {
+			return cached, nil
+		}
+	}
+
+	select {
+	case <-loading:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.current()
+}
+
+// current returns the cached snapshot, or the last reload error if there is none. s.mu must be held.
+func (s *FeatureService) current() (*snapshot, error) {
+	if s.cached == nil {
+		return nil, s.loadErr
+	}
+	return s.cached, nil
+}
+
+// reload loads a new snapshot without holding s.mu and closes done when finished.
+// It is detached from the request's cancellation, so a cancelled request does not fail the reload for the waiters.
+func (s *FeatureService) reload(ctx context.Context, done chan struct{}, generation uint64) {
+	loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	loaded, err := s.load(loadCtx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer close(done)
+
+	s.loading = nil
+	s.loadErr = err
+	if err != nil {
+		if s.cached != nil {
+			logger.FromContext(ctx).Warn("기능 플래그 갱신 실패 - 이전 값 사용", "error", err)
+		}
+	} else {
+		s.cached = loaded
+	}
+	if generation == s.generation {
+		s.loadedAt = time.Now()
+	}
+}
+
+func (s *FeatureService) load(ctx context.Context) (*snapshot, error) {
+	flags, err := s.featureRepository.FindAllFlags(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("기능 플래그 조회 실패: %w", err)
+	}
+	windows, err := s.featureRepository.FindAllMaintenance(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("점검 모드 조회 실패: %w", err)
+	}
+
+	loaded := &snapshot{flags: make(map[string]model.FeatureFlag, len(flags)), windows: windows}
+	for _, flag := range flags {
+		loaded.flags[flag.Key] = flag
+	}
+	return loaded, nil
+}
+
+// invalidate makes the next evaluation reload; other instances follow after FEATURE_CACHE_TTL
+func (s *FeatureService) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	s.loadedAt = time.Time{}
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	items := strings.Split(value, ",")
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+	return items
+}
+
+func joinIDs(ids []uint32) string {
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(items, ",")
+}
+
+func toFlagResponse(flag *model.FeatureFlag) FlagResponse {
+	response := FlagResponse{
+		Key:            flag.Key,
+		Description:    flag.Description,
+		Enabled:        flag.Enabled,
+		Environments:   []string{},
+		Percentage:     flag.Percentage,
+		AllowMemberIDs: []uint32{},
+		UpdatedAt:      flag.UpdatedAt,
+	}
+	response.Environments = append(response.Environments, splitList(flag.Environments)...)
+	for _, item := range splitList(flag.AllowMemberIDs) {
+		if id, err := strconv.ParseUint(item, 10, 32); err == nil {
+			response.AllowMemberIDs = append(response.AllowMemberIDs, uint32(id))
+		}
+	}
+	return response
+}
+
+func toMaintenanceResponse(window *model.MaintenanceWindow) MaintenanceResponse {
+	return MaintenanceResponse{
+		Scope:     window.Scope,
+		Mode:      window.Mode,
+		Reason:    window.Reason,
+		EndsAt:    window.EndsAt,
+		UpdatedAt: window.UpdatedAt,
+	}
+}
@@ -0,0 +1,194 @@
+package legal_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/legal"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupTestRouter registers the legal routes without caching; member routes authenticate as member 1
+func setupTestRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	return setupTestRouterWithCache(t, 0)
+}
+
+func setupTestRouterWithCache(t *testing.T, cacheTTL time.Duration) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	legalService := legal.NewLegalService(db, legal.NewLegalRepository(), cacheTTL)
+	legalHandler := legal.NewLegalHandler(legalService)
+
+	router := testutil.SetupTestRouter()
+	router.GET("/api/v1/meta/legal/:type", handler.Handle(legalHandler.GetDocument))
+
+	me := router.Group("/api/v1/members/me")
+	me.Use(func(c *gin.Context) {
+		c.Set(sharedContext.MemberIDKey, "1")
+		c.Next()
+	}, legal.ConsentCheck(legalService))
+	me.GET("/consents", handler.Handle(legalHandler.GetConsents))
+	me.POST("/consents", handler.Handle(legalHandler.Consent))
+
+	return router, db
+}
+
+func createDocument(t *testing.T, db *gorm.DB, docType, version string, effectiveAt time.Time) {
+	t.Helper()
+
+	require.NoError(t, db.Create(&model.LegalDocument{
+		Type:        docType,
+		Version:     version,
+		Title:       docType + " " + version,
+		Content:     "# " + version,
+		Required:    true,
+		EffectiveAt: effectiveAt,
+	}).Error)
+}
+
+func TestGetDocument(t *testing.T) {
+	// Given
+	router, db := setupTestRouter(t)
+	now := time.Now()
+	createDocument(t, db, model.LegalTermsOfService, "2024-01", now.Add(-48*time.Hour))
+	createDocument(t, db, model.LegalTermsOfService, "2025-01", now.Add(-time.Hour))
+	createDocument(t, db, model.LegalTermsOfService, "2026-01", now.Add(24*time.Hour)) // not in effect yet
+
+	testCases := []struct {
+		name            string
+		url             string
+		expectedVersion string
+	}{
+		{name: "Current version", url: "/api/v1/meta/legal/terms_of_service", expectedVersion: "2025-01"},
+		{name: "Specific version", url: "/api/v1/meta/legal/terms_of_service?version=2024-01", expectedVersion: "2024-01"},
+		{name: "Upcoming version", url: "/api/v1/meta/legal/terms_of_service?version=2026-01", expectedVersion: "2026-01"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: tc.url})
+
+			// Then
+			require.Equal(t, http.StatusOK, recorder.Code)
+			var response legal.DocumentResponse
+			testutil.ParseResponse(t, recorder, &response)
+			assert.Equal(t, tc.expectedVersion, response.Version)
+			assert.Equal(t, model.LegalTermsOfService, response.Type)
+			assert.Equal(t, "# "+tc.expectedVersion, response.Content)
+		})
+	}
+}
+
+func TestGetDocument_NotFound(t *testing.T) {
+	// Given
+	router, db := setupTestRouter(t)
+	createDocument(t, db, model.LegalTermsOfService, "2025-01", time.Now().Add(-time.Hour))
+
+	for _, url := range []string{
+		"/api/v1/meta/legal/privacy_policy",
+		"/api/v1/meta/legal/terms_of_service?version=1999-01",
+	} {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: url})
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, recorder.Code, url)
+		var errorResponse sharedError.ErrorResponse
+		testutil.ParseResponse(t, recorder, &errorResponse)
+		assert.Equal(t, "LEGAL-001", errorResponse.Code)
+	}
+}
+
+func TestConsent_Reconsent(t *testing.T) {
+	// Given: the member accepted the current terms
+	router, db := setupTestRouter(t)
+	createDocument(t, db, model.LegalTermsOfService, "2024-01", time.Now().Add(-48*time.Hour))
+
+	consent := func(version string) testutil.TestRequest {
+		return testutil.TestRequest{
+			Method: http.MethodPost,
+			URL:    "/api/v1/members/me/consents",
+			Body: legal.ConsentRequest{Consents: []legal.ConsentItem{
+				{Type: model.LegalTermsOfService, Version: version},
+			}},
+		}
+	}
+	status := testutil.TestRequest{Method: http.MethodGet, URL: "/api/v1/members/me/consents"}
+
+	recorder := testutil.ExecuteRequest(t, router, consent("2024-01"))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// When: a new version takes effect
+	createDocument(t, db, model.LegalTermsOfService, "2025-01", time.Now().Add(-time.Minute))
+	recorder = testutil.ExecuteRequest(t, router, status)
+
+	// Then: re-consent is reported, not enforced
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, model.LegalTermsOfService, recorder.Header().Get(legal.ConsentRequiredHeader))
+	var response legal.ConsentStatusResponse
+	testutil.ParseResponse(t, recorder, &response)
+	assert.True(t, response.ReconsentRequired)
+	require.Len(t, response.Pending, 1)
+	assert.Equal(t, "2025-01", response.Pending[0].Version)
+
+	// When: accepting the old version again
+	recorder = testutil.ExecuteRequest(t, router, consent("2024-01"))
+
+	// Then
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	var errorResponse sharedError.ErrorResponse
+	testutil.ParseResponse(t, recorder, &errorResponse)
+	assert.Equal(t, "LEGAL-003", errorResponse.Code)
+
+	// When: accepting the current version
+	recorder = testutil.ExecuteRequest(t, router, consent("2025-01"))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	recorder = testutil.ExecuteRequest(t, router, status)
+
+	// Then
+	assert.Empty(t, recorder.Header().Get(legal.ConsentRequiredHeader))
+	response = legal.ConsentStatusResponse{}
+	testutil.ParseResponse(t, recorder, &response)
+	assert.False(t, response.ReconsentRequired)
+	assert.Len(t, response.Consents, 2)
+}
+
+func TestConsentCheck_CachedStatusClearedOnConsent(t *testing.T) {
+	// Given: pending consent cached for the member
+	router, db := setupTestRouterWithCache(t, time.Hour)
+	createDocument(t, db, model.LegalTermsOfService, "2025-01", time.Now().Add(-time.Hour))
+	status := testutil.TestRequest{Method: http.MethodGet, URL: "/api/v1/members/me/consents"}
+
+	recorder := testutil.ExecuteRequest(t, router, status)
+	require.Equal(t, model.LegalTermsOfService, recorder.Header().Get(legal.ConsentRequiredHeader))
+
+	// When
+	recorder = testutil.ExecuteRequest(t, router, testutil.TestRequest{
+		Method: http.MethodPost,
+		URL:    "/api/v1/members/me/consents",
+		Body: legal.ConsentRequest{Consents: []legal.ConsentItem{
+			{Type: model.LegalTermsOfService, Version: "2025-01"},
+		}},
+	})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	recorder = testutil.ExecuteRequest(t, router, status)
+
+	// Then: the cached status was dropped
+	assert.Empty(t, recorder.Header().Get(legal.ConsentRequiredHeader))
+}
@@ -0,0 +1,41 @@
+package legal
+
+import "time"
+
+type DocumentResponse struct {
+	Type        string    `json:"type"`
+	Version     string    `json:"version"`
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	Required    bool      `json:"required"`
+	EffectiveAt time.Time `json:"effectiveAt"`
+}
+
+// ConsentItem is one accepted document version, sent on signup and to the consent endpoint
+type ConsentItem struct {
+	Type    string `json:"type" binding:"required,oneof=terms_of_service privacy_policy"`
+	Version string `json:"version" binding:"required,max=20"`
+}
+
+type ConsentRequest struct {
+	Consents []ConsentItem `json:"consents" binding:"required,min=1,dive"`
+}
+
+type ConsentStatusResponse struct {
+	ReconsentRequired bool              `json:"reconsentRequired"` // 동의하지 않은 필수 문서가 있으면 true
+	Pending           []PendingConsent  `json:"pending"`           // 동의가 필요한 현재 버전 문서
+	Consents          []ConsentResponse `json:"consents"`          // 동의 이력
+}
+
+type PendingConsent struct {
+	Type        string    `json:"type"`
+	Version     string    `json:"version"`
+	Title       string    `json:"title"`
+	EffectiveAt time.Time `json:"effectiveAt"`
+}
+
+type ConsentResponse struct {
+	Type     string    `json:"type"`
+	Version  string    `json:"version"`
+	AgreedAt time.Time `json:"agreedAt"`
+}
@@ -0,0 +1,39 @@
+package legal
+
+import (
+	"net/http"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+)
+
+const (
+	documentNotFound = "LEGAL_DOCUMENT_NOT_FOUND" // errInfo
+	consentRequired  = "LEGAL_CONSENT_REQUIRED"   // errInfo
+	documentOutdated = "LEGAL_DOCUMENT_OUTDATED"  // errInfo
+)
+
+var (
+	ErrDocumentNotFound = sharedError.NewDomainError(documentNotFound)
+	ErrConsentRequired  = sharedError.NewDomainError(consentRequired)
+	ErrDocumentOutdated = sharedError.NewDomainError(documentOutdated)
+)
+
+func init() {
+	sharedError.RegisterDomainErrorResponse(documentNotFound, sharedError.ErrorResponse{
+		Status:     http.StatusNotFound,
+		Code:       "LEGAL-001",
+		MessageKey: "legal.document_not_found",
+	})
+
+	sharedError.RegisterDomainErrorResponse(consentRequired, sharedError.ErrorResponse{
+		Status:     http.StatusBadRequest,
+		Code:       "LEGAL-002",
+		MessageKey: "legal.consent_required",
+	})
+
+	sharedError.RegisterDomainErrorResponse(documentOutdated, sharedError.ErrorResponse{
+		Status:     http.StatusConflict,
+		Code:       "LEGAL-003",
+		MessageKey: "legal.document_outdated",
+	})
+}
@@ -0,0 +1,45 @@
+package legal
+
+import (
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/gin-gonic/gin"
+)
+
+type LegalHandler struct {
+	legalService *LegalService
+}
+
+func NewLegalHandler(legalService *LegalService) *LegalHandler {
+	return &LegalHandler{
+		legalService: legalService,
+	}
+}
+
+// GetDocument returns the current version of a legal document (?version= for a specific one)
+func (h *LegalHandler) GetDocument(c *gin.Context) (any, error) {
+	return h.legalService.GetDocument(c.Request.Context(), c.Param("type"), c.Query("version"))
+}
+
+func (h *LegalHandler) GetConsents(c *gin.Context) (any, error) {
+	memberID, err := sharedContext.MemberID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.legalService.ConsentStatus(c.Request.Context(), memberID)
+}
+
+func (h *LegalHandler) Consent(c *gin.Context) (any, error) {
+	memberID, err := sharedContext.MemberID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var request ConsentRequest
+	if err := handler.ShouldBindJSON(c, &request); err != nil {
+		return nil, err
+	}
+
+	return h.legalService.Consent(c.Request.Context(), memberID, &request)
+}
@@ -0,0 +1,42 @@
+package legal
+
+import (
+	"strings"
+
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ConsentRequiredHeader lists the document types the member must accept again (e.g. "terms_of_service,privacy_policy")
+const ConsentRequiredHeader = "X-Consent-Required"
+
+// ConsentCheck sets X-Consent-Required on responses to members who have not accepted the current
+// version of a required document. Requests are not blocked; the app prompts for re-consent and
+// sends it to POST /api/v1/members/me/consents. Use it behind JWT.
+func ConsentCheck(legalService *LegalService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		memberID, ok := sharedContext.GetMemberID(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		pending, err := legalService.PendingConsents(c.Request.Context(), memberID)
+		if err != nil {
+			// Reporting consent status must not fail the request itself
+			logger.FromContext(c.Request.Context()).Warn("약관 동의 상태 확인 실패", "member_id", memberID, "error", err)
+			c.Next()
+			return
+		}
+
+		if len(pending) > 0 {
+			types := make([]string, 0, len(pending))
+			for _, document := range pending {
+				types = append(types, document.Type)
+			}
+			c.Header(ConsentRequiredHeader, strings.Join(types, ","))
+		}
+		c.Next()
+	}
+}
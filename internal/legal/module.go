@@ -0,0 +1,26 @@
+package legal
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/route"
+	"gorm.io/gorm"
+)
+
+// Module provides the legal documents and member consents
+var Module = di.Module{
+	Name: "legal",
+	Providers: []any{
+		NewLegalRepository,
+		func(db *gorm.DB, legalRepository *LegalRepository, cfg *config.Config) *LegalService {
+			return NewLegalService(db, legalRepository, cfg.Legal.CacheTTL)
+		},
+		NewLegalHandler,
+	},
+	Routes: func(api *route.API, h *LegalHandler) {
+		api.Meta.GET("/legal/:type", handler.Handle(h.GetDocument))
+		api.Members.GET("/me/consents", handler.Handle(h.GetConsents))
+		api.Members.POST("/me/consents", handler.Handle(h.Consent))
+	},
+}
@@ -0,0 +1,75 @@
+package legal
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"gorm.io/gorm"
+)
+
+type LegalRepository struct{}
+
+func NewLegalRepository() *LegalRepository {
+	return &LegalRepository{}
+}
+
+// FindCurrent returns the version in effect at now of each document type: the latest effective_at
+// not after now (the highest id on a tie). Content is not loaded.
+func (r *LegalRepository) FindCurrent(ctx context.Context, db *gorm.DB, now time.Time) ([]model.LegalDocument, error) {
+	var documents []model.LegalDocument
+	err := db.WithContext(ctx).
+		Omit("content").
+		Where("effective_at <= ?", now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM legal_document newer
+			WHERE newer.type = legal_document.type AND newer.effective_at <= ?
+			AND (newer.effective_at > legal_document.effective_at
+				OR (newer.effective_at = legal_document.effective_at AND newer.id > legal_document.id)))`, now).
+		Order("type").
+		Find(&documents).Error
+	return documents, err
+}
+
+func (r *LegalRepository) FindByTypeAndVersion(ctx context.Context, db *gorm.DB, docType, version string) (*model.LegalDocument, error) {
+	var document model.LegalDocument
+	err := db.WithContext(ctx).Where("type = ? AND version = ?", docType, version).First(&document).Error
+	if err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (r *LegalRepository) CreateDocument(ctx context.Context, db *gorm.DB, document *model.LegalDocument) error {
+	return db.WithContext(ctx).Create(document).Error
+}
+
+// FindConsentedDocumentIDs returns which of documentIDs the member has accepted
+func (r *LegalRepository) FindConsentedDocumentIDs(ctx context.Context, db *gorm.DB, memberID uint32, documentIDs []uint64) ([]uint64, error) {
+	var ids []uint64
+	if len(documentIDs) == 0 {
+		return ids, nil
+	}
+
+	err := db.WithContext(ctx).
+		Model(&model.MemberConsent{}).
+		Where("member_id = ? AND document_id IN ?", memberID, documentIDs).
+		Pluck("document_id", &ids).Error
+	return ids, err
+}
+
+func (r *LegalRepository) FindConsents(ctx context.Context, db *gorm.DB, memberID uint32) ([]model.MemberConsent, error) {
+	var consents []model.MemberConsent
+	err := db.WithContext(ctx).
+		Where("member_id = ?", memberID).
+		Order("agreed_at DESC, id DESC").
+		Find(&consents).Error
+	return consents, err
+}
+
+func (r *LegalRepository) CreateConsents(ctx context.Context, db *gorm.DB, consents []model.MemberConsent) error {
+	if len(consents) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(&consents).Error
+}
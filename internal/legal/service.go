@@ -0,0 +1,312 @@
+package legal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"gorm.io/gorm"
+)
+
+type LegalService struct {
+	db              *gorm.DB
+	legalRepository *LegalRepository
+	cacheTTL        time.Duration
+
+	// current documents, reloaded after cacheTTL so a new version takes effect without a restart
+	mu         sync.Mutex
+	current    []model.LegalDocument
+	loadedAt   time.Time
+	generation uint64 // incremented on every reload, invalidates the pending cache
+
+	// pending required documents per member, so ConsentCheck does not query consents on every request
+	pendingMu       sync.Mutex
+	pending         map[uint32]pendingEntry
+	pendingPurgedAt time.Time
+	consentEpoch    uint64 // incremented on every consent, so a result computed before it is not cached
+}
+
+// pendingEntry is a member's pending documents computed against one generation of the current documents
+type pendingEntry struct {
+	documents  []model.LegalDocument
+	generation uint64
+	expiresAt  time.Time
+}
+
+func NewLegalService(db *gorm.DB, legalRepository *LegalRepository, cacheTTL time.Duration) *LegalService {
+	return &LegalService{
+		db:              db,
+		legalRepository: legalRepository,
+		cacheTTL:        cacheTTL,
+		pending:         make(map[uint32]pendingEntry),
+	}
+}
+
+// GetDocument returns the current version of docType, or the given version when not empty
+func (s *LegalService) GetDocument(ctx context.Context, docType, version string) (*DocumentResponse, error) {
+	if version != "" {
+		document, err := s.legalRepository.FindByTypeAndVersion(ctx, s.db, docType, version)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("약관을 찾을 수 없습니다: type=%s version=%s %w", docType, version, ErrDocumentNotFound)
+			}
+			return nil, fmt.Errorf("약관 조회 실패: %w", err)
+		}
+		return toDocumentResponse(document), nil
+	}
+
+	current, _, err := s.currentDocuments(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(current, func(d model.LegalDocument) bool { return d.Type == docType })
+	if index < 0 {
+		return nil, fmt.Errorf("시행 중인 약관이 없습니다: type=%s %w", docType, ErrDocumentNotFound)
+	}
+
+	// The cached documents carry no Content
+	document, err := s.legalRepository.FindByTypeAndVersion(ctx, s.db, docType, current[index].Version)
+	if err != nil {
+		return nil, fmt.Errorf("약관 조회 실패: %w", err)
+	}
+	return toDocumentResponse(document), nil
+}
+
+// PendingConsents returns the current required documents the member has not accepted yet.
+// The result is cached for cacheTTL, or until the current documents change or the member consents.
+func (s *LegalService) PendingConsents(ctx context.Context, memberID uint32) ([]model.LegalDocument, error) {
+	current, generation, err := s.currentDocuments(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, epoch, ok := s.cachedPending(memberID, generation)
+	if ok {
+		return pending, nil
+	}
+	pending, err = s.findPending(ctx, memberID, current)
+	if err != nil {
+		return nil, err
+	}
+	s.cachePending(memberID, generation, epoch, pending)
+	return pending, nil
+}
+
+// findPending returns the required documents of current the member has not accepted
+func (s *LegalService) findPending(ctx context.Context, memberID uint32, current []model.LegalDocument) ([]model.LegalDocument, error) {
+	var required []model.LegalDocument
+	var ids []uint64
+	for _, document := range current {
+		if document.Required {
+			required = append(required, document)
+			ids = append(ids, document.ID)
+		}
+	}
+	if len(required) == 0 {
+		return nil, nil
+	}
+
+	consented, err := s.legalRepository.FindConsentedDocumentIDs(ctx, s.db, memberID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("약관 동의 조회 실패: memberID=%d %w", memberID, err)
+	}
+
+	var pending []model.LegalDocument
+	for _, document := range required {
+		if !slices.Contains(consented, document.ID) {
+			pending = append(pending, document)
+		}
+	}
+	return pending, nil
+}
+
+// RecordConsents stores the member's consent to the current versions in items using tx.
+// Every item must name the current version of its type (ErrDocumentOutdated). With requireAll,
+// as on signup, every current required document must be included (ErrConsentRequired).
+// Versions the member already accepted are skipped.
+func (s *LegalService) RecordConsents(ctx context.Context, tx *gorm.DB, memberID uint32, items []ConsentItem, requireAll bool) error {
+	current, _, err := s.currentDocuments(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	accepted := make(map[uint64]model.LegalDocument, len(items))
+	for _, item := range items {
+		index := slices.IndexFunc(current, func(d model.LegalDocument) bool { return d.Type == item.Type })
+		if index < 0 || current[index].Version != item.Version {
+			return fmt.Errorf("현재 시행 중인 약관 버전이 아닙니다: type=%s version=%s %w", item.Type, item.Version, ErrDocumentOutdated)
+		}
+		accepted[current[index].ID] = current[index]
+	}
+
+	if requireAll {
+		for _, document := range current {
+			if _, ok := accepted[document.ID]; document.Required && !ok {
+				return fmt.Errorf("필수 약관 동의 누락: type=%s version=%s %w", document.Type, document.Version, ErrConsentRequired)
+			}
+		}
+	}
+
+	ids := make([]uint64, 0, len(accepted))
+	for id := range accepted {
+		ids = append(ids, id)
+	}
+	consented, err := s.legalRepository.FindConsentedDocumentIDs(ctx, tx, memberID, ids)
+	if err != nil {
+		return fmt.Errorf("약관 동의 조회 실패: memberID=%d %w", memberID, err)
+	}
+
+	now := time.Now()
+	var consents []model.MemberConsent
+	for id, document := range accepted {
+		if slices.Contains(consented, id) {
+			continue
+		}
+		consents = append(consents, model.MemberConsent{
+			MemberID:     memberID,
+			DocumentID:   id,
+			DocumentType: document.Type,
+			Version:      document.Version,
+			AgreedAt:     now,
+		})
+	}
+
+	if err := s.legalRepository.CreateConsents(ctx, tx, consents); err != nil {
+		return fmt.Errorf("약관 동의 저장 실패: memberID=%d %w", memberID, err)
+	}
+	if len(consents) > 0 {
+		logger.FromContext(ctx).Info("약관 동의 저장", "member_id", memberID, "count", len(consents))
+	}
+	return nil
+}
+
+// Consent records the member's consent and returns the updated status
+func (s *LegalService) Consent(ctx context.Context, memberID uint32, request *ConsentRequest) (*ConsentStatusResponse, error) {
+	err := database.WithTransaction(ctx, s.db, func(tx *gorm.DB) error {
+		return s.RecordConsents(ctx, tx, memberID, request.Consents, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.forgetPending(memberID)
+	return s.ConsentStatus(ctx, memberID)
+}
+
+// ConsentStatus returns the documents awaiting consent and the member's consent history
+func (s *LegalService) ConsentStatus(ctx context.Context, memberID uint32) (*ConsentStatusResponse, error) {
+	pending, err := s.PendingConsents(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	consents, err := s.legalRepository.FindConsents(ctx, s.db, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("약관 동의 이력 조회 실패: memberID=%d %w", memberID, err)
+	}
+
+	response := &ConsentStatusResponse{
+		ReconsentRequired: len(pending) > 0,
+		Pending:           make([]PendingConsent, 0, len(pending)),
+		Consents:          make([]ConsentResponse, 0, len(consents)),
+	}
+	for _, document := range pending {
+		response.Pending = append(response.Pending, PendingConsent{
+			Type:        document.Type,
+			Version:     document.Version,
+			Title:       document.Title,
+			EffectiveAt: document.EffectiveAt,
+		})
+	}
+	for _, consent := range consents {
+		response.Consents = append(response.Consents, ConsentResponse{
+			Type:     consent.DocumentType,
+			Version:  consent.Version,
+			AgreedAt: consent.AgreedAt,
+		})
+	}
+	return response, nil
+}
+
+// currentDocuments returns the version in effect of each document type (without Content) and the
+// generation of the cache, loading it with db when the cache expired
+func (s *LegalService) currentDocuments(ctx context.Context, db *gorm.DB) ([]model.LegalDocument, uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < s.cacheTTL {
+		return s.current, s.generation, nil
+	}
+
+	current, err := s.legalRepository.FindCurrent(ctx, db, time.Now())
+	if err != nil {
+		return nil, 0, fmt.Errorf("시행 중인 약관 조회 실패: %w", err)
+	}
+
+	s.current = current
+	s.loadedAt = time.Now()
+	s.generation++
+	return current, s.generation, nil
+}
+
+// cachedPending returns the member's cached pending documents, and the consent epoch to pass to cachePending on a miss
+func (s *LegalService) cachedPending(memberID uint32, generation uint64) ([]model.LegalDocument, uint64, bool) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	entry, ok := s.pending[memberID]
+	if !ok || entry.generation != generation || !time.Now().Before(entry.expiresAt) {
+		return nil, s.consentEpoch, false
+	}
+	return entry.documents, s.consentEpoch, true
+}
+
+func (s *LegalService) cachePending(memberID uint32, generation, epoch uint64, documents []model.LegalDocument) {
+	if s.cacheTTL <= 0 {
+		return
+	}
+
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	if epoch != s.consentEpoch {
+		// A consent was committed while this was computed; the result may already be outdated
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(s.pendingPurgedAt) > s.cacheTTL {
+		for id, entry := range s.pending {
+			if entry.generation != generation || !now.Before(entry.expiresAt) {
+				delete(s.pending, id)
+			}
+		}
+		s.pendingPurgedAt = now
+	}
+	s.pending[memberID] = pendingEntry{documents: documents, generation: generation, expiresAt: now.Add(s.cacheTTL)}
+}
+
+// forgetPending drops the member's cached pending documents after a committed consent
+func (s *LegalService) forgetPending(memberID uint32) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	delete(s.pending, memberID)
+	s.consentEpoch++
+}
+
+func toDocumentResponse(document *model.LegalDocument) *DocumentResponse {
+	return &DocumentResponse{
+		Type:        document.Type,
+		Version:     document.Version,
+		Title:       document.Title,
+		Content:     document.Content,
+		Required:    document.Required,
+		EffectiveAt: document.EffectiveAt,
+	}
+}
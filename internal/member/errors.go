@@ -18,14 +18,14 @@ var (
 
 func init() {
 	sharedError.RegisterDomainErrorResponse(memberNotFound, sharedError.ErrorResponse{
-		Status:  http.StatusNotFound,
-		Code:    "MEMBER-001",
-		Message: "회원 정보를 찾을 수 없습니다.",
+		Status:     http.StatusNotFound,
+		Code:       "MEMBER-001",
+		MessageKey: "member.not_found",
 	})
 
 	sharedError.RegisterDomainErrorResponse(memberAlreadyExists, sharedError.ErrorResponse{
-		Status:  http.StatusConflict,
-		Code:    "MEMBER-002",
-		Message: "이미 가입된 사용자입니다.",
+		Status:     http.StatusConflict,
+		Code:       "MEMBER-002",
+		MessageKey: "member.already_exists",
 	})
 }
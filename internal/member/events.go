@@ -0,0 +1,17 @@
+package member
+
+import "time"
+
+const memberSignedUp = "member.signed_up" // event type
+
+// MemberSignedUp is published when a new member account is created
+type MemberSignedUp struct {
+	MemberID   uint32    `json:"memberId"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+func (MemberSignedUp) EventType() string {
+	return memberSignedUp
+}
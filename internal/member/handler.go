@@ -2,8 +2,6 @@ package member
 
 import (
 	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
-	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
-	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,22 +15,11 @@ func NewMemberHandler(memberService *MemberService) *MemberHandler {
 	}
 }
 
-func (h *MemberHandler) GetProfile(c *gin.Context) {
-	MemberID, ok := sharedContext.RequireMemberID(c)
-	if !ok {
-		return
-	}
-
-	response, err := h.memberService.GetProfile(c.Request.Context(), MemberID)
+func (h *MemberHandler) GetProfile(c *gin.Context) (any, error) {
+	memberID, err := sharedContext.MemberID(c)
 	if err != nil {
-		if resp, ok := sharedError.ResolveDomainError(err); ok {
-			handler.RespondError(c, err, resp)
-			return
-		}
-
-		handler.RespondError(c, err, sharedError.InternalServerError)
-		return
+		return nil, err
 	}
 
-	c.JSON(200, response)
+	return h.memberService.GetProfile(c.Request.Context(), memberID)
 }
@@ -0,0 +1,54 @@
+package member
+
+import (
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/token"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminOnly lets in operators with the internal token (X-Internal-Token) and members with the admin
+// role (created with the create-admin command) with their access token. Other requests get 403 (ERROR-013),
+// or 401 when the access token is invalid.
+func AdminOnly(memberService *MemberService, tokenManager token.Manager, internalToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if middleware.IsInternalRequest(c, internalToken) {
+			c.Next()
+			return
+		}
+
+		log := logger.FromContext(c.Request.Context()).With(
+			"client_ip", c.ClientIP(),
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+		)
+		if c.GetHeader(middleware.AuthorizationHeader) == "" {
+			log.Warn("관리자 API 접근 거부 - 인증 정보 없음")
+			handler.WriteError(c, sharedError.Forbidden)
+			c.Abort()
+			return
+		}
+		if !middleware.Authenticate(c, tokenManager) {
+			return
+		}
+
+		memberID, _ := sharedContext.GetMemberID(c)
+		admin, err := memberService.IsAdmin(c.Request.Context(), memberID)
+		if err != nil {
+			log.Error("관리자 권한 확인 실패", "member_id", memberID, "error", err)
+			handler.WriteError(c, sharedError.InternalServerError)
+			c.Abort()
+			return
+		}
+		if !admin {
+			log.Warn("관리자 API 접근 거부 - 관리자 권한 없음", "member_id", memberID)
+			handler.WriteError(c, sharedError.Forbidden)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,16 @@
+package member
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/route"
+)
+
+// Module provides the member profile
+var Module = di.Module{
+	Name:      "member",
+	Providers: []any{NewMemberRepository, NewMemberService, NewMemberHandler},
+	Routes: func(api *route.API, h *MemberHandler) {
+		api.Members.GET("/me", handler.Handle(h.GetProfile))
+	},
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
@@ -47,3 +50,46 @@ func (s *MemberService) GetProfile(ctx context.Context, memberID uint32) (*GetPr
 
 	return response, nil
 }
+
+// CreateAdmin creates an admin account (create-admin command). The password is stored as a bcrypt hash.
+func (s *MemberService) CreateAdmin(ctx context.Context, name, email, phoneNumber, password string) (*model.Member, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("비밀번호 해싱 실패: %w", err)
+	}
+
+	admin := model.NewMember(name, email, phoneNumber, string(hashedPassword))
+	admin.Role = model.RoleAdmin
+
+	err = database.WithTransaction(ctx, s.db, func(tx *gorm.DB) error {
+		exists, err := s.memberRepository.IsExist(ctx, tx, email)
+		if err != nil {
+			return fmt.Errorf("회원 존재 확인 오류: email=%s %w", logger.MaskEmail(email), err)
+		}
+		if exists {
+			return fmt.Errorf("이미 존재하는 회원입니다: email=%s %w", logger.MaskEmail(email), ErrMemberAlreadyExists)
+		}
+
+		if err := s.memberRepository.Create(ctx, tx, admin); err != nil {
+			return fmt.Errorf("관리자 계정 생성 실패: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return admin, nil
+}
+
+// IsAdmin reports whether the member has the admin role. Unknown members are not admins.
+func (s *MemberService) IsAdmin(ctx context.Context, memberID uint32) (bool, error) {
+	member, err := s.memberRepository.FindByID(ctx, s.db, memberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("회원 조회 실패: memberID=%d %w", memberID, err)
+	}
+	return member.Role == model.RoleAdmin, nil
+}
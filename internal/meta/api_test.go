@@ -0,0 +1,144 @@
+package meta_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/meta"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/router"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/buildinfo"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupProbes registers the application health checks against a SQLite database
+func setupProbes(t *testing.T) (*gin.Engine, *config.Config, *database.DB, *health.Registry) {
+	t.Helper()
+
+	cfg := testutil.NewTestConfig()
+	cfg.Client = config.ClientConfig{IOSMinVersion: "1.4.0", IOSLatestVersion: "1.6.2", AndroidLatestVersion: "2.1.0"}
+	db := &database.DB{DB: testutil.SetupTestDB(t)}
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db.DB)
+	})
+
+	probes := health.NewRegistry(cfg.Health)
+	router.RegisterHealthChecks(probes, cfg, db)
+
+	metaHandler := meta.NewHandler(cfg, config.NewReloader(cfg, config.LoadOptions{}), probes)
+	engine := testutil.SetupTestRouter()
+	engine.GET("/livez", metaHandler.Livez)
+	engine.GET("/readyz", metaHandler.Readyz)
+	engine.GET("/startupz", metaHandler.Startupz)
+	engine.GET("/api/v1/meta/version", handler.Handle(metaHandler.Version))
+
+	return engine, cfg, db, probes
+}
+
+func probe(t *testing.T, engine *gin.Engine, url, token string) (int, map[string]any) {
+	t.Helper()
+
+	req := testutil.TestRequest{Method: http.MethodGet, URL: url}
+	if token != "" {
+		req.Headers = map[string]string{middleware.InternalTokenHeader: token}
+	}
+	recorder := testutil.ExecuteRequest(t, engine, req)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	return recorder.Code, body
+}
+
+func TestProbes_Healthy(t *testing.T) {
+	// Given
+	engine, cfg, _, probes := setupProbes(t)
+	probes.MarkStarted()
+
+	for _, url := range []string{"/livez", "/readyz", "/startupz"} {
+		t.Run(url, func(t *testing.T) {
+			// When
+			status, public := probe(t, engine, url, "")
+			_, detailed := probe(t, engine, url, cfg.Internal.Token)
+
+			// Then
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, map[string]any{"status": "up"}, public)
+			assert.Equal(t, "up", detailed["status"])
+			assert.Contains(t, detailed, "checks")
+		})
+	}
+}
+
+func TestProbes_DetailsRequireInternalToken(t *testing.T) {
+	// Given: a migration is missing
+	engine, cfg, db, probes := setupProbes(t)
+	require.NoError(t, db.Migrator().DropTable("member"))
+	probes.MarkStarted()
+
+	// When
+	status, public := probe(t, engine, "/startupz", "")
+	_, wrongToken := probe(t, engine, "/startupz", "not-the-token")
+	_, detailed := probe(t, engine, "/startupz", cfg.Internal.Token)
+
+	// Then
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, map[string]any{"status": "down"}, public)
+	assert.Equal(t, public, wrongToken)
+
+	checks := detailed["checks"].(map[string]any)
+	migrations := checks["migrations"].(map[string]any)
+	assert.Equal(t, "down", migrations["status"])
+	assert.Contains(t, migrations["error"], "model.Member")
+}
+
+func TestProbes_ReadinessFailsWhileDraining(t *testing.T) {
+	// Given
+	engine, _, _, probes := setupProbes(t)
+	probes.MarkStarted()
+
+	// When
+	probes.StartDraining()
+
+	// Then
+	status, body := probe(t, engine, "/readyz", "")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, health.ReasonShuttingDown, body["reason"])
+
+	status, _ = probe(t, engine, "/livez", "")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestProbes_NotStarted(t *testing.T) {
+	engine, _, _, _ := setupProbes(t)
+
+	status, body := probe(t, engine, "/readyz", "")
+
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, health.ReasonStarting, body["reason"])
+}
+
+func TestVersion(t *testing.T) {
+	// Given
+	engine, _, _, _ := setupProbes(t)
+
+	// When
+	recorder := testutil.ExecuteRequest(t, engine, testutil.TestRequest{Method: http.MethodGet, URL: "/api/v1/meta/version"})
+
+	// Then
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var body meta.VersionResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, buildinfo.Version, body.Server.Version)
+	assert.NotEmpty(t, body.Server.GoVersion)
+	assert.Equal(t, meta.ClientVersion{MinVersion: "1.4.0", LatestVersion: "1.6.2"}, body.Clients["ios"])
+	assert.Equal(t, meta.ClientVersion{LatestVersion: "2.1.0"}, body.Clients["android"])
+}
@@ -0,0 +1,13 @@
+package meta
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/buildinfo"
+
+type VersionResponse struct {
+	Server  buildinfo.Info           `json:"server"`
+	Clients map[string]ClientVersion `json:"clients"` // ios, android
+}
+
+type ClientVersion struct {
+	MinVersion    string `json:"minVersion,omitempty"`    // 이보다 낮으면 강제 업데이트
+	LatestVersion string `json:"latestVersion,omitempty"` // 최신 버전 (선택 업데이트 안내)
+}
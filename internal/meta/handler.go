@@ -1,76 +1,84 @@
 package meta
 
 import (
-	"context"
-	"log/slog"
 	"net/http"
-	"time"
 
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
-	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/buildinfo"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
 	"github.com/gin-gonic/gin"
 )
 
-// Handler handles meta endpoints (health check, app version, legal documents, etc.)
+// Handler handles meta endpoints (health check, app version, etc.)
+// Legal documents under /api/v1/meta/legal are served by legal.LegalHandler.
 type Handler struct {
-	cfg *config.Config
-	db  *database.DB
+	cfg      *config.Config
+	reloader *config.Reloader
+	probes   *health.Registry
 }
 
 // NewHandler creates a new meta handler
-func NewHandler(cfg *config.Config, db *database.DB) *Handler {
+func NewHandler(cfg *config.Config, reloader *config.Reloader, probes *health.Registry) *Handler {
 	return &Handler{
-		cfg: cfg,
-		db:  db,
+		cfg:      cfg,
+		reloader: reloader,
+		probes:   probes,
 	}
 }
 
-// Health checks service and database health
-func (h *Handler) Health(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
-	defer cancel()
+// Version returns the server build info and the minimum/latest app versions per platform
+func (h *Handler) Version(c *gin.Context) (any, error) {
+	client := h.reloader.Current().Client
 
-	// Check database connectivity
-	dbStatus := "up"
-	var dbError string
-	start := time.Now()
+	clients := make(map[string]ClientVersion, 2)
+	for _, platform := range []string{config.PlatformIOS, config.PlatformAndroid} {
+		minVersion, latestVersion, _ := client.Versions(platform)
+		clients[platform] = ClientVersion{MinVersion: minVersion, LatestVersion: latestVersion}
+	}
 
-	if err := h.db.HealthCheck(ctx); err != nil {
-		dbStatus = "down"
-		dbError = err.Error()
-		slog.Error("Health check 실패", "error", err)
+	return VersionResponse{Server: buildinfo.Get(), Clients: clients}, nil
+}
 
-		c.JSON(http.StatusServiceUnavailable, gin.H{
-			"status": "unhealthy",
-			"service": gin.H{
-				"name":        h.cfg.App.Name,
-				"environment": h.cfg.App.Env,
-			},
-			"checks": gin.H{
-				"database": gin.H{
-					"status": dbStatus,
-					"error":  dbError,
-				},
-			},
-		})
-		return
+// Livez reports whether the process is alive (no dependency checks)
+func (h *Handler) Livez(c *gin.Context) {
+	h.probe(c, health.Liveness)
+}
+
+// Readyz reports whether the instance can serve traffic. It fails while starting and during graceful shutdown.
+func (h *Handler) Readyz(c *gin.Context) {
+	h.probe(c, health.Readiness)
+}
+
+// Startupz reports whether initialization finished (database reachable, migrations applied)
+func (h *Handler) Startupz(c *gin.Context) {
+	h.probe(c, health.Startup)
+}
+
+// probe runs the checks of p and answers 200 or 503.
+// Check details (errors, latency) are only returned to requests with the internal token.
+func (h *Handler) probe(c *gin.Context, p health.Probe) {
+	report := h.probes.Run(c.Request.Context(), p)
+
+	status := http.StatusOK
+	if !report.Healthy() {
+		status = http.StatusServiceUnavailable
 	}
+	c.Header("Cache-Control", "no-store")
 
-	dbLatency := time.Since(start).Milliseconds()
+	if !middleware.IsInternalRequest(c, h.cfg.Internal.Token) {
+		c.JSON(status, health.Report{Status: report.Status, Reason: report.Reason})
+		return
+	}
 
-	// All checks passed
-	c.JSON(http.StatusOK, gin.H{
-		"status": "healthy",
+	c.JSON(status, gin.H{
+		"status": report.Status,
+		"reason": report.Reason,
+		"probe":  p.String(),
 		"service": gin.H{
 			"name":        h.cfg.App.Name,
 			"environment": h.cfg.App.Env,
-			"port":        h.cfg.App.Port,
-		},
-		"checks": gin.H{
-			"database": gin.H{
-				"status":     dbStatus,
-				"latency_ms": dbLatency,
-			},
 		},
+		"checks": report.Checks,
 	})
 }
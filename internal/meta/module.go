@@ -0,0 +1,28 @@
+package meta
+
+import (
+	"expvar"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/route"
+	"github.com/gin-gonic/gin"
+)
+
+// Module provides the probes, the app version endpoint and the expvar metrics
+var Module = di.Module{
+	Name:      "meta",
+	Providers: []any{NewHandler},
+	Routes: func(api *route.API, h *Handler) {
+		api.Engine.GET("/livez", h.Livez)
+		api.Engine.GET("/readyz", h.Readyz)
+		api.Engine.GET("/startupz", h.Startupz)
+		api.Engine.GET("/health", h.Readyz) // deprecated: /readyz
+
+		// Not behind the client version check: outdated apps call it to find out about the update
+		api.Meta.GET("/version", handler.Handle(h.Version))
+
+		// expvar counters (database_transaction, ...), operators only
+		api.Admin.GET("/debug/vars", gin.WrapH(expvar.Handler()))
+	},
+}
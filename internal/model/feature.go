@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// FeatureFlag turns a feature on per environment, for a percentage of members or for listed members.
+// A disabled flag is off for everyone.
+type FeatureFlag struct {
+	// Primary key - Oracle IDENTITY (auto-increment)
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	Key            string `gorm:"column:flag_key;type:VARCHAR2(100);not null;uniqueIndex"` // 플래그 이름 (e.g. rooms)
+	Description    string `gorm:"column:description;type:VARCHAR2(500)"`                   // 설명
+	Enabled        bool   `gorm:"column:enabled;not null"`                                 // false: 모두에게 꺼짐
+	Environments   string `gorm:"column:environments;type:VARCHAR2(200)"`                  // 켜질 환경 (쉼표 구분, 빈 값: 전체)
+	Percentage     int    `gorm:"column:percentage;not null"`                              // 켜질 회원 비율 (0~100, 회원 ID 기준 고정 분배)
+	AllowMemberIDs string `gorm:"column:allow_member_ids;type:VARCHAR2(4000)"`             // 비율과 관계없이 켜질 회원 ID (쉼표 구분)
+
+	BaseEntity
+}
+
+// TableName specifies the table name for FeatureFlag
+func (*FeatureFlag) TableName() string {
+	return "feature_flag"
+}
+
+// Maintenance modes
+const (
+	MaintenanceModeMaintenance = "maintenance" // 모든 요청 503
+	MaintenanceModeReadOnly    = "read_only"   // 조회(GET, HEAD, OPTIONS)만 허용
+)
+
+// MaintenanceWindow puts a route group (scope) into maintenance or read-only mode until EndsAt
+type MaintenanceWindow struct {
+	// Primary key - Oracle IDENTITY (auto-increment)
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	Scope  string     `gorm:"column:scope;type:VARCHAR2(100);not null;uniqueIndex"` // 라우트 그룹 (e.g. members, * : 전체)
+	Mode   string     `gorm:"column:mode;type:VARCHAR2(20);not null"`               // maintenance | read_only
+	Reason string     `gorm:"column:reason;type:VARCHAR2(500)"`                     // 작업 사유 (운영 기록용, 응답에 노출하지 않음)
+	EndsAt *time.Time `gorm:"column:ends_at"`                                       // 종료 예정 시각 (Retry-After 계산, 지나면 자동 해제, nil: 수동 해제)
+
+	BaseEntity
+}
+
+// TableName specifies the table name for MaintenanceWindow
+func (*MaintenanceWindow) TableName() string {
+	return "maintenance_window"
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// IdempotencyRecord stores the response of a request sent with an Idempotency-Key header
+type IdempotencyRecord struct {
+	Scope       string    `gorm:"column:scope;type:VARCHAR2(64);primaryKey"`                // 회원 ID (비로그인 요청은 anonymous)
+	Key         string    `gorm:"column:idempotency_key;type:VARCHAR2(255);primaryKey"`     // 클라이언트가 보낸 Idempotency-Key
+	RequestHash string    `gorm:"column:request_hash;type:VARCHAR2(64);not null"`           // method + path + body SHA-256
+	Status      int       `gorm:"column:status;not null;default:0"`                         // 응답 상태 코드 (0: 처리 중)
+	Header      string    `gorm:"column:header;type:CLOB"`                                  // 재전송할 응답 헤더 (JSON)
+	Body        string    `gorm:"column:body;type:CLOB"`                                    // 응답 body
+	ExpiresAt   time.Time `gorm:"column:expires_at;not null;index:idx_idempotency_expires"` // 만료 시각 (처리 중: lock TTL, 완료: TTL)
+	CreatedAt   time.Time `gorm:"column:created_at;not null"`                               // GORM이 자동 관리
+	UpdatedAt   time.Time `gorm:"column:updated_at;not null"`                               // GORM이 자동 관리
+}
+
+// TableName specifies the table name for IdempotencyRecord
+func (*IdempotencyRecord) TableName() string {
+	return "idempotency_record"
+}
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+// Scheduled job status
+const (
+	JobStatusPending = "PENDING" // 실행 대기 (재시도 포함)
+	JobStatusRunning = "RUNNING" // 워커가 실행 중 (locked_until 만료 시 재획득 가능)
+	JobStatusDone    = "DONE"    // 실행 완료
+	JobStatusFailed  = "FAILED"  // 재시도 한도 초과
+)
+
+// ScheduledJob is a one-off delayed job persisted in the database
+type ScheduledJob struct {
+	// Primary key - Oracle IDENTITY (auto-increment)
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	Name        string     `gorm:"column:name;type:VARCHAR2(100);not null"`                                     // 등록된 Job 핸들러 이름
+	Payload     string     `gorm:"column:payload;type:CLOB"`                                                    // JSON payload
+	Status      string     `gorm:"column:status;type:VARCHAR2(20);not null;index:idx_scheduled_job_status_run"` // PENDING | RUNNING | DONE | FAILED
+	Attempts    int        `gorm:"column:attempts;not null;default:0"`                                          // 실행 시도 횟수
+	RunAt       time.Time  `gorm:"column:run_at;not null;index:idx_scheduled_job_status_run"`                   // 실행 예정 시각
+	LockedBy    string     `gorm:"column:locked_by;type:VARCHAR2(100)"`                                         // 실행 중인 인스턴스
+	LockedUntil *time.Time `gorm:"column:locked_until"`                                                         // 실행 잠금 만료 시각
+	LastError   string     `gorm:"column:last_error;type:VARCHAR2(1000)"`                                       // 마지막 실패 사유
+	FinishedAt  *time.Time `gorm:"column:finished_at"`                                                          // 완료 시각
+	CreatedAt   time.Time  `gorm:"column:created_at;not null"`                                                  // GORM이 자동 관리
+	UpdatedAt   time.Time  `gorm:"column:updated_at;not null"`                                                  // GORM이 자동 관리
+}
+
+// TableName specifies the table name for ScheduledJob
+func (*ScheduledJob) TableName() string {
+	return "scheduled_job"
+}
+
+// JobLease is a named lease used for leader election between replicas
+type JobLease struct {
+	Name      string    `gorm:"column:name;type:VARCHAR2(100);primaryKey"` // lease 이름 (e.g. cron:outbox.purge)
+	Holder    string    `gorm:"column:holder;type:VARCHAR2(100);not null"` // 현재 보유 인스턴스
+	ExpiresAt time.Time `gorm:"column:expires_at;not null"`                // 만료 시각
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`                // GORM이 자동 관리
+}
+
+// TableName specifies the table name for JobLease
+func (*JobLease) TableName() string {
+	return "job_lease"
+}
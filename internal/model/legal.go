@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// Legal document types
+const (
+	LegalTermsOfService = "terms_of_service" // 서비스 이용약관
+	LegalPrivacyPolicy  = "privacy_policy"   // 개인정보 처리방침
+)
+
+// LegalDocument is one version of a legal document.
+// The version with the latest effective_at that is not in the future is the current one.
+type LegalDocument struct {
+	// Primary key - Oracle IDENTITY (auto-increment)
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	Type        string    `gorm:"column:type;type:VARCHAR2(30);not null;uniqueIndex:idx_legal_document_type_version"`    // terms_of_service | privacy_policy
+	Version     string    `gorm:"column:version;type:VARCHAR2(20);not null;uniqueIndex:idx_legal_document_type_version"` // 문서 버전 (e.g. 2025-01)
+	Title       string    `gorm:"column:title;type:VARCHAR2(200);not null"`                                              // 제목
+	Content     string    `gorm:"column:content;type:CLOB;not null"`                                                     // 본문 (Markdown)
+	Required    bool      `gorm:"column:required;not null"`                                                              // 동의 필수 여부
+	EffectiveAt time.Time `gorm:"column:effective_at;not null"`                                                          // 시행일
+
+	BaseEntity
+}
+
+// TableName specifies the table name for LegalDocument
+func (*LegalDocument) TableName() string {
+	return "legal_document"
+}
+
+// MemberConsent records that a member accepted a version of a legal document
+type MemberConsent struct {
+	// Primary key - Oracle IDENTITY (auto-increment)
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	MemberID     uint32    `gorm:"column:member_id;not null;uniqueIndex:idx_member_consent_document"`   // 회원 ID
+	DocumentID   uint64    `gorm:"column:document_id;not null;uniqueIndex:idx_member_consent_document"` // 동의한 문서 버전 (legal_document.id)
+	DocumentType string    `gorm:"column:document_type;type:VARCHAR2(30);not null"`                     // 문서 종류 (조회 편의용)
+	Version      string    `gorm:"column:version;type:VARCHAR2(20);not null"`                           // 문서 버전 (조회 편의용)
+	AgreedAt     time.Time `gorm:"column:agreed_at;not null"`                                           // 동의 시각
+	CreatedAt    time.Time `gorm:"column:created_at;not null"`                                          // GORM이 자동 관리
+}
+
+// TableName specifies the table name for MemberConsent
+func (*MemberConsent) TableName() string {
+	return "member_consent"
+}
@@ -1,5 +1,11 @@
 package model
 
+// Member roles
+const (
+	RoleMember = "member"
+	RoleAdmin  = "admin" // created with the create-admin command only, allowed on the admin API
+)
+
 // Member represents a user in the system
 // Oracle sequence MEMBER_SEQ is used for ID generation
 type Member struct {
@@ -11,6 +17,7 @@ type Member struct {
 	Name        string `gorm:"column:name;type:VARCHAR2(100);not null"`                               // 이름
 	PhoneNumber string `gorm:"column:phone_number;type:VARCHAR2(100);not null"`                       // 핸드폰 번호
 	Password    string `gorm:"column:password;type:VARCHAR2(60);not null"`                            // 암호화된 비밀번호
+	Role        string `gorm:"column:role;type:VARCHAR2(20);not null;default:'member'"`               // 권한 (member | admin)
 
 	BaseEntity
 }
@@ -29,5 +36,6 @@ func NewMember(name, email, phoneNumber, password string) *Member {
 		Email:       email,
 		PhoneNumber: phoneNumber,
 		Password:    password, // This should be hashed password
+		Role:        RoleMember,
 	}
 }
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Outbox event status
+const (
+	OutboxStatusPending    = "PENDING"    // 발행 대기 (재시도 포함)
+	OutboxStatusProcessing = "PROCESSING" // dispatcher가 처리 중 (next_attempt_at 경과 시 재처리)
+	OutboxStatusProcessed  = "PROCESSED"  // 모든 핸들러 처리 완료
+	OutboxStatusDead       = "DEAD"       // 재시도 한도 초과 (dead-letter)
+)
+
+// OutboxEvent is a domain event written in the same transaction as the business change
+// and delivered asynchronously by the outbox dispatcher (transactional outbox pattern)
+type OutboxEvent struct {
+	// Primary key - Oracle IDENTITY (auto-increment), also defines delivery order
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	EventType     string     `gorm:"column:event_type;type:VARCHAR2(100);not null"`                               // 이벤트 타입 (e.g. member.signed_up)
+	Payload       string     `gorm:"column:payload;type:CLOB;not null"`                                           // JSON payload
+	Status        string     `gorm:"column:status;type:VARCHAR2(20);not null;index:idx_outbox_event_status_next"` // PENDING | PROCESSING | PROCESSED | DEAD
+	Attempts      int        `gorm:"column:attempts;not null;default:0"`                                          // 처리 시도 횟수
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;not null;index:idx_outbox_event_status_next"`          // 다음 처리 가능 시각
+	LastError     string     `gorm:"column:last_error;type:VARCHAR2(1000)"`                                       // 마지막 실패 사유
+	ProcessedAt   *time.Time `gorm:"column:processed_at"`                                                         // 처리 완료 시각
+	CreatedAt     time.Time  `gorm:"column:created_at;not null"`                                                  // GORM이 자동 관리
+	UpdatedAt     time.Time  `gorm:"column:updated_at;not null"`                                                  // GORM이 자동 관리
+}
+
+// TableName specifies the table name for OutboxEvent
+func (*OutboxEvent) TableName() string {
+	return "outbox_event"
+}
@@ -0,0 +1,11 @@
+package router
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/auth"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/outbox"
+)
+
+// RegisterEventHandlers subscribes all domain event handlers to the outbox registry
+func RegisterEventHandlers(registry *outbox.Registry) {
+	auth.RegisterEventHandlers(registry)
+}
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/outbox"
+)
+
+// RegisterHealthChecks registers the checks behind /readyz and /startupz
+func RegisterHealthChecks(registry *health.Registry, cfg *config.Config, db *database.DB) {
+	registry.Register(health.Check{Name: "database", Checker: health.CheckerFunc(db.HealthCheck)})
+	registry.Register(health.Check{
+		Name:    "migrations",
+		Checker: health.CheckerFunc(db.CheckMigrations),
+		Probes:  []health.Probe{health.Startup},
+	})
+
+	if cfg.Health.OutboxMaxLag > 0 {
+		registry.Register(health.Check{
+			Name: "outbox",
+			Checker: health.CheckerFunc(func(ctx context.Context) error {
+				return outbox.CheckLag(ctx, db.DB, cfg.Health.OutboxMaxLag)
+			}),
+			Probes:      []health.Probe{health.Readiness},
+			NonCritical: true,
+		})
+	}
+
+	if cfg.Health.DiskMinFreeBytes > 0 {
+		registry.Register(health.Check{
+			Name:    "disk",
+			Checker: health.DiskChecker(cfg.Health.DiskPath, cfg.Health.DiskMinFreeBytes),
+			Probes:  []health.Probe{health.Readiness},
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package router
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/idempotency"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/job"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/outbox"
+)
+
+// RegisterJobs registers all periodic jobs and delayed job handlers
+func RegisterJobs(scheduler *job.Scheduler, cfg *config.Config, db *database.DB) error {
+	if err := scheduler.Schedule("outbox.purge", cfg.Outbox.PurgeSchedule, func(ctx context.Context) error {
+		deleted, err := outbox.PurgeProcessed(ctx, db.DB, cfg.Outbox.Retention)
+		if err != nil {
+			return err
+		}
+		logger.FromContext(ctx).Info("처리 완료 outbox 이벤트 삭제", "deleted", deleted)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := scheduler.Schedule("job.purge", cfg.Job.PurgeSchedule, func(ctx context.Context) error {
+		deleted, err := job.PurgeFinished(ctx, db.DB, cfg.Job.Retention)
+		if err != nil {
+			return err
+		}
+		logger.FromContext(ctx).Info("완료된 지연 Job 삭제", "deleted", deleted)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if cfg.Idempotency.Store == "database" {
+		if err := scheduler.Schedule("idempotency.purge", cfg.Idempotency.PurgeSchedule, func(ctx context.Context) error {
+			deleted, err := idempotency.PurgeExpired(ctx, db.DB)
+			if err != nil {
+				return err
+			}
+			logger.FromContext(ctx).Info("만료된 idempotency key 삭제", "deleted", deleted)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package router_test
+
+import (
+	"testing"
+
+	// Importing router registers every domain error response (auth, member, middleware)
+	_ "github.com/changhyeonkim/pray-together/go-api-server/internal/router"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/i18n"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageKeys_TranslatedInEveryLocale(t *testing.T) {
+	keys := append(sharedError.MessageKeys(), validator.MessageKeys()...)
+
+	for _, key := range keys {
+		assert.NotEmpty(t, key, "error response registered without MessageKey")
+		for _, locale := range i18n.Locales() {
+			assert.True(t, i18n.Has(locale, key), "missing translation: locale=%s key=%s", locale, key)
+		}
+	}
+}
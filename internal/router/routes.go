@@ -3,44 +3,95 @@ package router
 import (
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/auth"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/feature"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/legal"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/member"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/meta"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/idempotency"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/route"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/token"
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
-// Setup configures all application-specific routes using dependency injection
-func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
-	// Meta handler (health check, app version, legal documents)
-	metaHandler := meta.NewHandler(cfg, db)
-	router.GET("/health", metaHandler.Health)
-
-	// repository
-	memberRepository := member.NewMemberRepository()
+// Modules are the domain modules served by the API, in route registration order
+var Modules = []di.Module{
+	meta.Module,
+	auth.Module,
+	member.Module,
+	feature.Module,
+	legal.Module,
+}
 
-	// shared services
-	tokenManager := token.NewJWTManager(cfg)
+// Setup builds the application's components and registers every module's routes.
+// A missing or cyclic dependency is reported here, at startup.
+func Setup(router *gin.Engine, cfg *config.Config, reloader *config.Reloader, db *database.DB, probes *health.Registry) error {
+	return NewContainer(router, cfg, reloader, db, probes).Build()
+}
 
-	// service
-	authService := auth.NewAuthService(db.DB, memberRepository, tokenManager)
-	memberService := member.NewMemberService(db.DB, memberRepository)
+// NewContainer returns the container Setup builds, with the shared components and all Modules installed.
+// Tests replace single providers before Build:
+//
+//	container := router.NewContainer(engine, cfg, reloader, db, probes)
+//	container.Override(func() token.Manager { return testutil.NewMockTokenManager() })
+//	err := container.Build()
+func NewContainer(router *gin.Engine, cfg *config.Config, reloader *config.Reloader, db *database.DB, probes *health.Registry) *di.Container {
+	container := di.New()
+	container.Supply(router, cfg, reloader, db, probes)
+	container.Provide(
+		func(db *database.DB) *gorm.DB { return db.DB },
+		// One manager for issuing (auth) and validating (JWT middleware) tokens
+		func(cfg *config.Config) token.Manager { return token.NewJWTManager(cfg) },
+		newIdempotencyStore,
+		newAPI,
+	)
+	container.Install(Modules...)
+	return container
+}
 
-	// handler
-	authHandler := auth.NewAuthHandler(authService)
-	memberHandler := member.NewMemberHandler(memberService)
+// newAPI creates the route groups and their shared middleware
+func newAPI(
+	router *gin.Engine,
+	cfg *config.Config,
+	reloader *config.Reloader,
+	tokenManager token.Manager,
+	store idempotency.Store,
+	featureService *feature.FeatureService,
+	legalService *legal.LegalService,
+	memberService *member.MemberService,
+) *route.API {
+	clientVersion := middleware.AppVersion(reloader)
 
-	// API v1 routes
 	authV1 := router.Group("/api/v1/auth")
-	{
-		authV1.POST("/signup", authHandler.Signup)
-		authV1.POST("/login", authHandler.Login)
-	}
+	// Login only reads, so members can still sign in (and refresh their session) while auth is read-only
+	authV1.Use(clientVersion, feature.Maintenance(featureService, "auth", "POST /api/v1/auth/login"))
 
 	memberV1 := router.Group("/api/v1/members")
-	memberV1.Use(middleware.JWT(cfg))
-	{
-		memberV1.GET("/me", memberHandler.GetProfile)
+	memberV1.Use(clientVersion, feature.Maintenance(featureService, "members"), middleware.JWT(tokenManager), legal.ConsentCheck(legalService))
+
+	// Operator endpoints: X-Internal-Token, or the access token of an admin account (create-admin).
+	// Not behind Maintenance so they work during maintenance.
+	adminV1 := router.Group("/api/v1/admin")
+	adminV1.Use(member.AdminOnly(memberService, tokenManager, cfg.Internal.Token))
+
+	return &route.API{
+		Engine:     router,
+		Meta:       router.Group("/api/v1/meta"),
+		Auth:       authV1,
+		Members:    memberV1,
+		Admin:      adminV1,
+		Idempotent: middleware.Idempotency(store, cfg.Idempotency),
+	}
+}
+
+// newIdempotencyStore returns the Idempotency-Key store selected by IDEMPOTENCY_STORE
+func newIdempotencyStore(cfg *config.Config, db *gorm.DB) idempotency.Store {
+	if cfg.Idempotency.Store == "memory" {
+		return idempotency.NewMemoryStore()
 	}
+	return idempotency.NewGormStore(db)
 }
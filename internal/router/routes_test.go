@@ -0,0 +1,148 @@
+package router_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/router"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/token"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestContainer(t *testing.T) (*gin.Engine, *database.DB, *di.Container) {
+	t.Helper()
+
+	cfg := testutil.NewTestConfig()
+	db := &database.DB{DB: testutil.SetupTestDB(t)}
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db.DB)
+	})
+
+	engine := testutil.SetupTestRouter()
+	container := router.NewContainer(engine, cfg, config.NewReloader(cfg, config.LoadOptions{}), db, health.NewRegistry(cfg.Health))
+	return engine, db, container
+}
+
+func TestSetup_RegistersEveryModule(t *testing.T) {
+	// Given
+	engine, _, container := newTestContainer(t)
+
+	// When
+	require.NoError(t, container.Build())
+
+	// Then
+	routes := map[string]bool{}
+	for _, r := range engine.Routes() {
+		routes[r.Method+" "+r.Path] = true
+	}
+	for _, want := range []string{
+		"GET /readyz",
+		"GET /api/v1/meta/version",
+		"GET /api/v1/meta/legal/:type",
+		"POST /api/v1/auth/signup",
+		"GET /api/v1/members/me",
+		"GET /api/v1/members/me/features",
+		"PUT /api/v1/admin/flags/:key",
+	} {
+		assert.True(t, routes[want], "route not registered: %s", want)
+	}
+}
+
+func TestSetup_SharesTokenManager(t *testing.T) {
+	// Given: a token issued by the manager auth uses
+	engine, db, container := newTestContainer(t)
+	require.NoError(t, container.Build())
+
+	member := model.NewMember("홍길동", "hong@example.com", "010-1234-5678", "hashed")
+	require.NoError(t, db.DB.Create(member).Error)
+
+	manager, err := di.Resolve[token.Manager](container)
+	require.NoError(t, err)
+	accessToken, err := manager.GenerateAccessToken(strconv.FormatUint(uint64(member.ID), 10), member.Email)
+	require.NoError(t, err)
+
+	// When
+	recorder := testutil.ExecuteRequest(t, engine, testutil.TestRequest{
+		Method:  http.MethodGet,
+		URL:     "/api/v1/members/me",
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	})
+
+	// Then: the JWT middleware validates with the same manager
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestSetup_AdminRoutesRequireAdminRole(t *testing.T) {
+	// Given: an admin account and an ordinary member
+	engine, db, container := newTestContainer(t)
+	require.NoError(t, container.Build())
+	manager, err := di.Resolve[token.Manager](container)
+	require.NoError(t, err)
+
+	accessToken := func(role string) string {
+		member := model.NewMember("홍길동", role+"@example.com", "010-1234-5678", "hashed")
+		member.Role = role
+		require.NoError(t, db.DB.Create(member).Error)
+		accessToken, err := manager.GenerateAccessToken(strconv.FormatUint(uint64(member.ID), 10), member.Email)
+		require.NoError(t, err)
+		return accessToken
+	}
+	cfg := testutil.NewTestConfig()
+
+	for name, tc := range map[string]struct {
+		headers map[string]string
+		status  int
+	}{
+		"admin":          {map[string]string{"Authorization": "Bearer " + accessToken(model.RoleAdmin)}, http.StatusOK},
+		"member":         {map[string]string{"Authorization": "Bearer " + accessToken(model.RoleMember)}, http.StatusForbidden},
+		"internal token": {map[string]string{"X-Internal-Token": cfg.Internal.Token}, http.StatusOK},
+		"anonymous":      {nil, http.StatusForbidden},
+		"invalid token":  {map[string]string{"Authorization": "Bearer invalid"}, http.StatusUnauthorized},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, engine, testutil.TestRequest{
+				Method:  http.MethodGet,
+				URL:     "/api/v1/admin/flags",
+				Headers: tc.headers,
+			})
+
+			// Then
+			assert.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestSetup_OverrideProvider(t *testing.T) {
+	// Given: the token manager replaced by a mock that accepts any token
+	engine, db, container := newTestContainer(t)
+
+	member := model.NewMember("홍길동", "hong@example.com", "010-1234-5678", "hashed")
+	require.NoError(t, db.DB.Create(member).Error)
+
+	mock := testutil.NewMockTokenManager()
+	mock.ValidateTokenFunc = func(string) (*token.Claims, error) {
+		return &token.Claims{MemberID: strconv.FormatUint(uint64(member.ID), 10), Email: member.Email}, nil
+	}
+	container.Override(func() token.Manager { return mock })
+	require.NoError(t, container.Build())
+
+	// When
+	recorder := testutil.ExecuteRequest(t, engine, testutil.TestRequest{
+		Method:  http.MethodGet,
+		URL:     "/api/v1/members/me",
+		Headers: map[string]string{"Authorization": "Bearer anything"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
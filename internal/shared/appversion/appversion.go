@@ -0,0 +1,58 @@
+// Package appversion parses and compares mobile app versions ("1.4.0", "v2.0", "1.4.0-beta.1").
+package appversion
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a major.minor.patch version. Pre-release and build suffixes are ignored.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// Parse parses "MAJOR[.MINOR[.PATCH]]" with an optional "v" prefix; missing parts are 0
+func Parse(s string) (Version, error) {
+	core := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+
+	parts := strings.Split(core, ".")
+	if core == "" || len(parts) > 3 {
+		return Version{}, fmt.Errorf("유효하지 않은 앱 버전: %q", s)
+	}
+
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("유효하지 않은 앱 버전: %q", s)
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// Compare returns -1, 0 or 1 when v is older than, equal to or newer than other
+func (v Version) Compare(other Version) int {
+	for _, d := range [3]int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+	return 0
+}
+
+// Less reports whether v is older than other
+func (v Version) Less(other Version) bool {
+	return v.Compare(other) < 0
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
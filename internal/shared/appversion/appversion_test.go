@@ -0,0 +1,64 @@
+package appversion_test
+
+import (
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/appversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  appversion.Version
+	}{
+		{"1.4.2", appversion.Version{Major: 1, Minor: 4, Patch: 2}},
+		{"v2.0", appversion.Version{Major: 2}},
+		{"3", appversion.Version{Major: 3}},
+		{"1.4.0-beta.1", appversion.Version{Major: 1, Minor: 4}},
+		{" 1.10.0+build.7 ", appversion.Version{Major: 1, Minor: 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := appversion.Parse(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, input := range []string{"", "v", "1.2.3.4", "1.x", "-1.0", "1..2"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := appversion.Parse(input)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.4.0", "1.4", 0},
+		{"1.9.0", "1.10.0", -1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.4.1", "1.4.0", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, err := appversion.Parse(tt.a)
+			require.NoError(t, err)
+			b, err := appversion.Parse(tt.b)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, a.Compare(b))
+		})
+	}
+}
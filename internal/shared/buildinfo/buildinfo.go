@@ -0,0 +1,58 @@
+// Package buildinfo exposes the version of the running binary.
+//
+// Values are injected at build time:
+//
+//	go build -ldflags "\
+//	  -X github.com/changhyeonkim/pray-together/go-api-server/internal/shared/buildinfo.Version=1.4.0 \
+//	  -X github.com/changhyeonkim/pray-together/go-api-server/internal/shared/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/changhyeonkim/pray-together/go-api-server/internal/shared/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" \
+//	  ./cmd/server
+//
+// Without ldflags the commit and time recorded by the Go toolchain (vcs.revision, vcs.time) are used.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Info is the build information of the running binary
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+}
+
+var (
+	once sync.Once
+	info Info
+)
+
+// Get returns the build information, reading the toolchain values once
+func Get() Info {
+	once.Do(func() {
+		info = Info{Version: Version, Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+
+		bi, ok := debug.ReadBuildInfo()
+		if !ok {
+			return
+		}
+		for _, setting := range bi.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = setting.Value
+			case setting.Key == "vcs.time" && info.BuildTime == "":
+				info.BuildTime = setting.Value
+			}
+		}
+	})
+	return info
+}
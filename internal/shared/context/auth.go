@@ -15,6 +15,19 @@ const (
 	MemberEmailKey = "member_email"
 )
 
+const memberIDNotInContext = "MEMBER_ID_NOT_IN_CONTEXT" // errInfo
+
+// ErrMemberIDNotInContext is returned by MemberID when the route is not behind the JWT middleware
+var ErrMemberIDNotInContext = sharedError.NewDomainError(memberIDNotInContext)
+
+func init() {
+	sharedError.RegisterDomainErrorResponse(memberIDNotInContext, sharedError.ErrorResponse{
+		Status:     http.StatusUnauthorized,
+		Code:       "AUTH-000",
+		MessageKey: "auth.login_required",
+	})
+}
+
 func GetMemberID(c *gin.Context) (uint32, bool) {
 	memberID, exists := c.Get(MemberIDKey)
 	if !exists {
@@ -34,6 +47,17 @@ func GetMemberID(c *gin.Context) (uint32, bool) {
 	return uint32(id), true
 }
 
+// MemberID returns the authenticated member ID for handler.Func handlers.
+// Returns ErrMemberIDNotInContext (401) if the ID is not set.
+func MemberID(c *gin.Context) (uint32, error) {
+	memberID, ok := GetMemberID(c)
+	if !ok {
+		logger.FromContext(c.Request.Context()).Error("[API] context에 회원 ID가 존재하지 않습니다.")
+		return 0, ErrMemberIDNotInContext
+	}
+	return memberID, nil
+}
+
 // RequireMemberID retrieves the authenticated user's ID from the Gin context.
 // If the user ID is not found, automatically sends an authentication error response.
 // Returns the user ID and true if found, empty string and false if not found (error already sent).
@@ -41,12 +65,16 @@ func GetMemberID(c *gin.Context) (uint32, bool) {
 func RequireMemberID(c *gin.Context) (uint32, bool) {
 	memberID, ok := GetMemberID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, sharedError.ErrorResponse{
-			Status:  http.StatusUnauthorized,
-			Code:    "AUTH-000",
-			Message: "로그인을 해주세요.",
-		})
-		c.Abort()
+		locale := GetLocale(c)
+		resp := sharedError.Localize(sharedError.ErrorResponse{
+			Status:     http.StatusUnauthorized,
+			Code:       "AUTH-000",
+			MessageKey: "auth.login_required",
+		}, locale)
+		contentType, body := sharedError.Negotiate(resp, c.GetHeader("Accept"), GetRequestID(c))
+		c.Header("Content-Type", contentType)
+		c.Header("Content-Language", locale)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, body)
 		logger.FromContext(c.Request.Context()).Error("[API] context에 회원 ID가 존재하지 않습니다.")
 		return 0, false
 	}
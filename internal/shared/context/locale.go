@@ -0,0 +1,11 @@
+package context
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// GetLocale returns the supported locale that best matches the request's Accept-Language header
+func GetLocale(c *gin.Context) string {
+	return i18n.Match(c.GetHeader("Accept-Language"))
+}
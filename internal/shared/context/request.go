@@ -0,0 +1,16 @@
+package context
+
+import "github.com/gin-gonic/gin"
+
+// RequestIDKey is the gin context key holding the request ID (set by middleware.RequestID)
+const RequestIDKey = "request_id"
+
+// GetRequestID returns the request ID of the current request, or "" if none was assigned
+func GetRequestID(c *gin.Context) string {
+	if requestID, exists := c.Get(RequestIDKey); exists {
+		if id, ok := requestID.(string); ok {
+			return id
+		}
+	}
+	return ""
+}
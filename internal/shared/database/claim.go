@@ -0,0 +1,54 @@
+package database
+
+import (
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxLastErrorLength is the size of the last_error columns of the outbox and job tables
+const MaxLastErrorLength = 1000
+
+// Fetch reads at most limit rows of query for the caller to claim, skipping rows another replica
+// is claiming. Call it inside a transaction and mark the rows before committing.
+//
+// On Oracle the rows are locked with FOR UPDATE SKIP LOCKED. There is no ROWNUM limit: it is applied
+// before ORDER BY and before locked rows are skipped, so a replica would get fewer (or no) rows than
+// available. SKIP LOCKED locks rows as they are fetched, so the cursor stops after limit rows.
+// SQLite serializes writers and has no row locking clause, so LIMIT is used instead.
+func Fetch[T any](tx *gorm.DB, query *gorm.DB, limit int) ([]T, error) {
+	switch tx.Dialector.Name() {
+	case DialectOracle:
+		query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+	default:
+		query = query.Limit(limit)
+	}
+
+	rows, err := query.Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []T
+	for len(items) < limit && rows.Next() {
+		var item T
+		if err := tx.ScanRows(rows, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// Truncate cuts s to at most n bytes without splitting a UTF-8 character
+func Truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,37 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch_StopsAtLimit(t *testing.T) {
+	// Given
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, db.Create(&model.JobLease{Name: name, Holder: "test"}).Error)
+	}
+
+	// When
+	leases, err := database.Fetch[model.JobLease](db, db.Model(&model.JobLease{}).Order("name"), 2)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, leases, 2)
+	assert.Equal(t, "a", leases[0].Name)
+	assert.Equal(t, "b", leases[1].Name)
+}
+
+func TestTruncate_KeepsWholeCharacters(t *testing.T) {
+	assert.Equal(t, "abc", database.Truncate("abc", 10))
+	assert.Equal(t, "기", database.Truncate("기도", 4)) // 3 bytes per character
+	assert.Equal(t, "", database.Truncate("기도", 2))
+}
@@ -0,0 +1,40 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync/atomic"
+
+	go_ora "github.com/sijms/go-ora/v2"
+)
+
+// rotatingConnector opens each new pool connection with the latest DSN,
+// so a rotated DB password is picked up without restarting the server.
+// Existing connections keep working until ConnMaxLifetime recycles them.
+type rotatingConnector struct {
+	dsn    atomic.Pointer[string]
+	driver *go_ora.OracleDriver
+}
+
+func newRotatingConnector(dsn string) *rotatingConnector {
+	c := &rotatingConnector{driver: go_ora.NewDriver()}
+	c.SetDSN(dsn)
+	return c
+}
+
+// SetDSN replaces the DSN used for new connections
+func (c *rotatingConnector) SetDSN(dsn string) {
+	c.dsn.Store(&dsn)
+}
+
+func (c *rotatingConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	connector, err := c.driver.OpenConnector(*c.dsn.Load())
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+func (c *rotatingConnector) Driver() driver.Driver {
+	return c.driver
+}
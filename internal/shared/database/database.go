@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log/slog"
 	"net/url"
@@ -18,7 +19,7 @@ type DB struct {
 	*gorm.DB
 }
 
-// New creates a new database connection
+// New creates a new database connection. Migration is up to the caller (see Migrate).
 func New(cfg *config.Config) (*DB, error) {
 	dsn := buildDSN(cfg.Database)
 
@@ -31,7 +32,15 @@ func New(cfg *config.Config) (*DB, error) {
 		},
 	}
 
-	db, err := gorm.Open(oracle.Open(dsn), gormConfig)
+	// Follow DB password rotation (DB_PASSWORD=file://... re-read at runtime)
+	connector := newRotatingConnector(dsn)
+	cfg.OnSecretChange("database.password", func(password string) {
+		dbCfg := cfg.Database
+		dbCfg.Password = password
+		connector.SetDSN(buildDSN(dbCfg))
+	})
+
+	db, err := gorm.Open(oracle.New(oracle.Config{Conn: sql.OpenDB(connector)}), gormConfig)
 	if err != nil {
 		return nil, fmt.Errorf("데이터베이스 연결 실패: %w", err)
 	}
@@ -56,6 +65,9 @@ func New(cfg *config.Config) (*DB, error) {
 		return nil, fmt.Errorf("데이터베이스 핑 실패: %w", err)
 	}
 
+	// Configure transaction retry policy
+	SetDefaultRetryPolicy(RetryPolicyFromConfig(cfg.Database))
+
 	slog.Info("데이터베이스 연결 성공",
 		"host", cfg.Database.Host,
 		"service", cfg.Database.Service,
@@ -63,13 +75,9 @@ func New(cfg *config.Config) (*DB, error) {
 		"max_open_conns", cfg.Database.MaxOpenConns,
 		"conn_max_lifetime", cfg.Database.ConnMaxLifetime.String(),
 		"conn_max_idle_time", cfg.Database.ConnMaxIdleTime.String(),
+		"tx_max_attempts", cfg.Database.TxMaxAttempts,
 	)
 
-	// Run migration based on configuration
-	if err := Migrate(db, cfg); err != nil {
-		return nil, fmt.Errorf("마이그레이션 실패: %w", err)
-	}
-
 	return &DB{DB: db}, nil
 }
 
@@ -1,8 +1,10 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
@@ -10,7 +12,8 @@ import (
 	"gorm.io/gorm"
 )
 
-// Migrate executes database migration based on configuration
+// Migrate executes database migration based on configuration.
+// Called on server startup; DB_AUTO_MIGRATE=true recreates every table (see Reset).
 func Migrate(db *gorm.DB, cfg *config.Config) error {
 	if !cfg.Database.IsAutoMigrate {
 		slog.Info("⏭️  데이터베이스 마이그레이션 비활성화됨",
@@ -19,20 +22,25 @@ func Migrate(db *gorm.DB, cfg *config.Config) error {
 		return nil
 	}
 
+	return Reset(db, cfg)
+}
+
+// Reset drops every table and creates them again. Refused in production.
+func Reset(db *gorm.DB, cfg *config.Config) error {
 	slog.Warn("🔧 데이터베이스 마이그레이션 시작 - 모든 테이블이 삭제되고 재생성됩니다!",
-		"auto_migrate", true, "env", cfg.App.Env,
+		"env", cfg.App.Env,
 	)
 
 	// Safety check: prevent accidental data loss in production
-	if cfg.App.Env == "prod" || cfg.App.Env == "production" {
-		return fmt.Errorf("🚨 PRODUCTION 환경에서는 DB_AUTO_MIGRATE=true를 사용할 수 없습니다! 데이터 손실 방지를 위해 차단됨")
+	if cfg.IsProduction() {
+		return fmt.Errorf("🚨 PRODUCTION 환경에서는 테이블 재생성을 할 수 없습니다! 데이터 손실 방지를 위해 차단됨")
 	}
 
 	// Step 1: Drop all tables (Oracle)
 	slog.Info("🗑️  기존 테이블 삭제 중...")
 
 	// Order matters: drop in reverse dependency order (FK constraints)
-	tableNames := []string{"member"}
+	tableNames := []string{"maintenance_window", "feature_flag", "member_consent", "legal_document", "idempotency_record", "job_lease", "scheduled_job", "outbox_event", "member"}
 
 	for _, tableName := range tableNames {
 		// Check if table exists (Oracle)
@@ -52,7 +60,7 @@ func Migrate(db *gorm.DB, cfg *config.Config) error {
 
 	// Step 2: Create tables with IDENTITY columns
 	slog.Info("📦 새 테이블 생성 중...")
-	if err := runAutoMigrate(db); err != nil {
+	if err := AutoMigrate(db); err != nil {
 		return fmt.Errorf("테이블 생성 실패: %w", err)
 	}
 
@@ -60,17 +68,29 @@ func Migrate(db *gorm.DB, cfg *config.Config) error {
 	return nil
 }
 
-// runAutoMigrate creates tables based on model definitions
-func runAutoMigrate(db *gorm.DB) error {
-	// 중요: 의존성 순서대로 생성 (FK 참조 순서)
-	// 1. 독립 테이블 먼저
-	// 2. FK 참조하는 테이블은 나중에
-	models := []interface{}{
+// migrationModels returns the models managed by migration.
+// 중요: 의존성 순서대로 생성 (FK 참조 순서)
+// 1. 독립 테이블 먼저
+// 2. FK 참조하는 테이블은 나중에
+func migrationModels() []interface{} {
+	return []interface{}{
 		// Independent tables (no foreign keys)
 		&model.Member{},
+		&model.OutboxEvent{},
+		&model.ScheduledJob{},
+		&model.JobLease{},
+		&model.IdempotencyRecord{},
+		&model.LegalDocument{},
+		&model.MemberConsent{},
+		&model.FeatureFlag{},
+		&model.MaintenanceWindow{},
 	}
+}
 
-	for _, m := range models {
+// AutoMigrate creates missing tables and columns from the model definitions.
+// Existing data is kept (GORM AutoMigrate never drops columns).
+func AutoMigrate(db *gorm.DB) error {
+	for _, m := range migrationModels() {
 		if err := db.AutoMigrate(m); err != nil {
 			return fmt.Errorf("%T 마이그레이션 실패: %w", m, err)
 		}
@@ -79,3 +99,22 @@ func runAutoMigrate(db *gorm.DB) error {
 
 	return nil
 }
+
+// CheckMigrations returns an error naming the model tables that do not exist yet
+func (db *DB) CheckMigrations(ctx context.Context) error {
+	migrator := db.DB.WithContext(ctx).Migrator()
+
+	var missing []string
+	for _, m := range migrationModels() {
+		if !migrator.HasTable(m) {
+			missing = append(missing, fmt.Sprintf("%T", m))
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("마이그레이션 확인 실패: %w", err)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("마이그레이션되지 않은 테이블: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
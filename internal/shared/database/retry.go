@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"expvar"
+	"io"
+	"math/rand/v2"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/sijms/go-ora/v2/network"
+)
+
+// Dialect names as reported by gorm.Dialector.Name()
+const (
+	DialectOracle = "oracle"
+	DialectSQLite = "sqlite"
+)
+
+// RetryPolicy controls how WithTransaction retries transient failures.
+// Delays use exponential backoff with full jitter: sleep = rand(0, min(MaxDelay, BaseDelay*2^n)).
+type RetryPolicy struct {
+	MaxAttempts int // total attempts including the first one, 1 이하: 재시도 없음
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Transaction retry metrics, exposed via expvar under "database_transaction" (GET /api/v1/admin/debug/vars)
+var txMetrics = expvar.NewMap("database_transaction")
+
+var defaultRetryPolicy atomic.Pointer[RetryPolicy]
+
+func init() {
+	defaultRetryPolicy.Store(&RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   50 * time.Millisecond,
+		MaxDelay:    time.Second,
+	})
+}
+
+// RetryPolicyFromConfig builds a retry policy from database configuration
+func RetryPolicyFromConfig(cfg config.DatabaseConfig) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: cfg.TxMaxAttempts,
+		BaseDelay:   cfg.TxRetryBaseDelay,
+		MaxDelay:    cfg.TxRetryMaxDelay,
+	}
+}
+
+// SetDefaultRetryPolicy replaces the policy used by WithTransaction when no option overrides it
+func SetDefaultRetryPolicy(policy RetryPolicy) {
+	defaultRetryPolicy.Store(&policy)
+}
+
+// DefaultRetryPolicy returns the policy currently used by WithTransaction
+func DefaultRetryPolicy() RetryPolicy {
+	return *defaultRetryPolicy.Load()
+}
+
+// backoff returns the jittered delay before the given retry (retry starts at 1)
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	ceiling := p.BaseDelay << min(retry-1, 30)
+	if ceiling <= 0 || (p.MaxDelay > 0 && ceiling > p.MaxDelay) {
+		ceiling = p.MaxDelay
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+
+	return rand.N(ceiling + 1)
+}
+
+// TxOption customizes a single WithTransaction call
+type TxOption func(*txOptions)
+
+type txOptions struct {
+	policy RetryPolicy
+}
+
+// WithoutRetry disables retries for transactions whose fn has non-idempotent side effects
+// (e.g. calling an external API or sending a message that cannot be rolled back).
+func WithoutRetry() TxOption {
+	return func(o *txOptions) {
+		o.policy.MaxAttempts = 1
+	}
+}
+
+// WithRetryPolicy overrides the default retry policy for a single transaction
+func WithRetryPolicy(policy RetryPolicy) TxOption {
+	return func(o *txOptions) {
+		o.policy = policy
+	}
+}
+
+// Oracle error codes that are safe to retry after rolling back the whole transaction
+var retryableOracleCodes = map[int]struct{}{
+	60:   {}, // deadlock detected while waiting for resource
+	8177: {}, // can't serialize access for this transaction
+}
+
+// Oracle error codes for a lost connection: the outcome of an in-flight COMMIT is unknown
+var connectionOracleCodes = map[int]struct{}{
+	3113:  {}, // end-of-file on communication channel
+	3114:  {}, // not connected to ORACLE
+	3135:  {}, // connection lost contact
+	12170: {}, // TNS:Connect timeout occurred
+	12514: {}, // TNS:listener does not currently know of service
+	12516: {}, // TNS:listener could not find available handler
+	12528: {}, // TNS:listener: all appropriate instances are blocking new connections
+	12537: {}, // TNS:connection closed
+	12541: {}, // TNS:no listener
+	25408: {}, // can not safely replay call
+}
+
+var oracleCodePattern = regexp.MustCompile(`ORA-(\d{5})`)
+
+// IsRetryable reports whether err, raised before COMMIT was sent, is a transient failure for the given dialect.
+// The transaction is rolled back (or lost with its connection) in that case, so it left no effect.
+// Errors from COMMIT itself must be checked with IsConnectionError as well: see WithTransaction.
+func IsRetryable(dialect string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// Context cancellation is never retried: the caller gave up
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if IsConnectionError(dialect, err) {
+		return true
+	}
+
+	switch dialect {
+	case DialectOracle:
+		return hasOracleCode(err, retryableOracleCodes)
+	case DialectSQLite:
+		return isRetryableSQLite(err)
+	default:
+		return false
+	}
+}
+
+// IsConnectionError reports whether err means the connection was lost. When that happens during COMMIT
+// the server may have committed already, so the transaction must not be run again.
+func IsConnectionError(dialect string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// Dropped connections (driver-agnostic)
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, net.ErrClosed) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	if dialect == DialectOracle {
+		var oraErr *network.OracleError
+		if errors.As(err, &oraErr) && oraErr.Bad() {
+			return true
+		}
+		return hasOracleCode(err, connectionOracleCodes)
+	}
+	return false
+}
+
+// hasOracleCode reports whether err carries one of the given ORA- codes
+func hasOracleCode(err error, codes map[int]struct{}) bool {
+	var oraErr *network.OracleError
+	if errors.As(err, &oraErr) {
+		_, ok := codes[oraErr.ErrCode]
+		return ok
+	}
+
+	// Fallback: error chain lost the typed error (e.g. wrapped with %v)
+	for _, match := range oracleCodePattern.FindAllStringSubmatch(err.Error(), -1) {
+		code, convErr := strconv.Atoi(match[1])
+		if convErr != nil {
+			continue
+		}
+		if _, ok := codes[code]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isRetryableSQLite(err error) bool {
+	// SQLITE_BUSY(5) / SQLITE_LOCKED(6) - driver independent message matching
+	msg := err.Error()
+	return strings.Contains(msg, "database is locked") ||
+		strings.Contains(msg, "database table is locked") ||
+		strings.Contains(msg, "SQLITE_BUSY")
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"golang.org/x/crypto/bcrypt"
+
+	"gorm.io/gorm"
+)
+
+// Demo account created by Seed (local/dev only)
+const (
+	SeedMemberEmail    = "demo@praytogether.dev"
+	SeedMemberPassword = "demo12345!"
+)
+
+// seedLegalDocuments are placeholders so signup works on a fresh database;
+// real documents are published through the legal_document table.
+var seedLegalDocuments = []model.LegalDocument{
+	{Type: model.LegalTermsOfService, Version: "seed", Title: "서비스 이용약관", Content: "# 서비스 이용약관\n\n개발용 약관입니다.", Required: true},
+	{Type: model.LegalPrivacyPolicy, Version: "seed", Title: "개인정보 처리방침", Content: "# 개인정보 처리방침\n\n개발용 방침입니다.", Required: true},
+}
+
+// Seed inserts the development data: placeholder legal documents and a demo member.
+// Rows that already exist are left untouched, so it can run repeatedly. Refused in production.
+func Seed(ctx context.Context, db *gorm.DB, cfg *config.Config) error {
+	if cfg.IsProduction() {
+		return fmt.Errorf("🚨 PRODUCTION 환경에서는 seed 데이터를 넣을 수 없습니다")
+	}
+
+	return WithTransaction(ctx, db, func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		documents := make([]model.LegalDocument, 0, len(seedLegalDocuments))
+		for _, document := range seedLegalDocuments {
+			document.EffectiveAt = now
+			result := tx.WithContext(ctx).
+				Where(model.LegalDocument{Type: document.Type, Version: document.Version}).
+				FirstOrCreate(&document)
+			if result.Error != nil {
+				return fmt.Errorf("약관 seed 실패: type=%s %w", document.Type, result.Error)
+			}
+			slog.Info("약관 seed", "type", document.Type, "version", document.Version, "created", result.RowsAffected > 0)
+			documents = append(documents, document)
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(SeedMemberPassword), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("비밀번호 해싱 실패: %w", err)
+		}
+		member := model.NewMember("데모 사용자", SeedMemberEmail, "010-0000-0000", string(hashedPassword))
+		result := tx.WithContext(ctx).Where(model.Member{Email: member.Email}).FirstOrCreate(member)
+		if result.Error != nil {
+			return fmt.Errorf("회원 seed 실패: %w", result.Error)
+		}
+		slog.Info("회원 seed", "email", member.Email, "created", result.RowsAffected > 0)
+
+		// The demo member agrees to the seeded documents so the member API works right away
+		for _, document := range documents {
+			consent := model.MemberConsent{
+				MemberID:     member.ID,
+				DocumentID:   document.ID,
+				DocumentType: document.Type,
+				Version:      document.Version,
+				AgreedAt:     now,
+			}
+			err := tx.WithContext(ctx).
+				Where(model.MemberConsent{MemberID: member.ID, DocumentID: document.ID}).
+				FirstOrCreate(&consent).Error
+			if err != nil {
+				return fmt.Errorf("약관 동의 seed 실패: type=%s %w", document.Type, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,49 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestSeed_Idempotent(t *testing.T) {
+	// Given
+	db := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, db)
+	cfg := testutil.NewTestConfig()
+
+	// When: seeded twice
+	require.NoError(t, database.Seed(context.Background(), db, cfg))
+	require.NoError(t, database.Seed(context.Background(), db, cfg))
+
+	// Then
+	var documents, members, consents int64
+	db.Model(&model.LegalDocument{}).Count(&documents)
+	db.Model(&model.Member{}).Count(&members)
+	db.Model(&model.MemberConsent{}).Count(&consents)
+	assert.Equal(t, int64(2), documents)
+	assert.Equal(t, int64(1), members)
+	assert.Equal(t, int64(2), consents)
+
+	var member model.Member
+	require.NoError(t, db.Where("email = ?", database.SeedMemberEmail).First(&member).Error)
+	assert.Equal(t, model.RoleMember, member.Role)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(member.Password), []byte(database.SeedMemberPassword)))
+}
+
+func TestSeed_RefusedInProduction(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, db)
+	cfg := testutil.NewTestConfig()
+	cfg.App.Env = "prod"
+
+	err := database.Seed(context.Background(), db, cfg)
+
+	assert.Error(t, err)
+}
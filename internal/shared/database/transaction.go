@@ -3,7 +3,9 @@ package database
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
 	"gorm.io/gorm"
 )
 
@@ -12,6 +14,12 @@ import (
 // can use it directly. Calling WithContext again is optional (and safe) if you want to keep the
 // repository signature uniform for both transactional and non-transactional DB handles.
 //
+// Transient failures (deadlock, ORA-08177 serialization error, dropped connection, SQLITE_BUSY)
+// roll back and re-run fn with jittered backoff according to DefaultRetryPolicy.
+// fn must therefore be safe to run more than once; pass WithoutRetry() when it is not.
+// A connection lost during COMMIT is not retried (the write may have been applied), and neither is
+// a call nested in an outer transaction: only the savepoint would be rolled back, so the caller decides.
+//
 // Usage:
 //
 //	err := WithTransaction(ctx, db, func(tx *gorm.DB) error {
@@ -21,7 +29,10 @@ import (
 //	    }
 //	    return nil // commit
 //	})
-func WithTransaction(ctx context.Context, db *gorm.DB, fn func(*gorm.DB) error) error {
+//
+//	// non-idempotent side effect inside the transaction
+//	err := WithTransaction(ctx, db, fn, database.WithoutRetry())
+func WithTransaction(ctx context.Context, db *gorm.DB, fn func(*gorm.DB) error, opts ...TxOption) error {
 	if fn == nil {
 		return errors.New("database: transaction function is nil")
 	}
@@ -30,5 +41,81 @@ func WithTransaction(ctx context.Context, db *gorm.DB, fn func(*gorm.DB) error)
 		ctx = context.Background()
 	}
 
-	return db.WithContext(ctx).Transaction(fn)
+	options := txOptions{policy: DefaultRetryPolicy()}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	maxAttempts := max(options.policy.MaxAttempts, 1)
+	if inTransaction(db) {
+		maxAttempts = 1
+	}
+	dialect := db.Dialector.Name()
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		txMetrics.Add("attempts", 1)
+
+		committing := false
+		err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := fn(tx); err != nil {
+				return err
+			}
+			committing = true
+			return nil
+		})
+		if err == nil {
+			if attempt > 1 {
+				txMetrics.Add("retry_successes", 1)
+			}
+			return nil
+		}
+
+		if !IsRetryable(dialect, err) {
+			return err
+		}
+		if committing && IsConnectionError(dialect, err) {
+			txMetrics.Add("commit_unknown", 1)
+			logger.FromContext(ctx).Error("COMMIT 중 연결 끊김 - 반영 여부를 알 수 없어 재시도하지 않습니다",
+				"dialect", dialect,
+				"attempt", attempt,
+				"error", err,
+			)
+			return err
+		}
+
+		log := logger.FromContext(ctx)
+		if attempt >= maxAttempts {
+			if maxAttempts > 1 {
+				txMetrics.Add("retry_exhausted", 1)
+				log.Error("트랜잭션 재시도 한도 초과",
+					"dialect", dialect,
+					"attempts", attempt,
+					"error", err,
+				)
+				return fmt.Errorf("트랜잭션 재시도 한도 초과 (attempts=%d): %w", attempt, err)
+			}
+			return err
+		}
+
+		delay := options.policy.backoff(attempt)
+		txMetrics.Add("retries", 1)
+		log.Warn("일시적인 트랜잭션 오류 - 재시도합니다",
+			"dialect", dialect,
+			"attempt", attempt,
+			"max_attempts", maxAttempts,
+			"backoff", delay.String(),
+			"error", err,
+		)
+
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return errors.Join(err, sleepErr)
+		}
+	}
+}
+
+// inTransaction reports whether db is bound to an open transaction (gorm nests with a savepoint then)
+func inTransaction(db *gorm.DB) bool {
+	committer, ok := db.Statement.ConnPool.(gorm.TxCommitter)
+	return ok && committer != nil
 }
@@ -0,0 +1,166 @@
+package database_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/sijms/go-ora/v2/network"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var fastPolicy = database.RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    5 * time.Millisecond,
+}
+
+func TestWithTransaction_RetriesTransientError(t *testing.T) {
+	// Given: SQLite DB and a fn that fails with SQLITE_BUSY once
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	calls := 0
+	fn := func(tx *gorm.DB) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database is locked (5) (SQLITE_BUSY)")
+		}
+		return nil
+	}
+
+	// When
+	err := database.WithTransaction(context.Background(), db, fn, database.WithRetryPolicy(fastPolicy))
+
+	// Then: second attempt succeeds
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestWithTransaction_DoesNotRetryBusinessError(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	businessErr := errors.New("business rule violated")
+	calls := 0
+	err := database.WithTransaction(context.Background(), db, func(tx *gorm.DB) error {
+		calls++
+		return businessErr
+	}, database.WithRetryPolicy(fastPolicy))
+
+	assert.ErrorIs(t, err, businessErr)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWithTransaction_WithoutRetry(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	calls := 0
+	err := database.WithTransaction(context.Background(), db, func(tx *gorm.DB) error {
+		calls++
+		return errors.New("database is locked")
+	}, database.WithRetryPolicy(fastPolicy), database.WithoutRetry())
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWithTransaction_RetryExhausted(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	lockedErr := errors.New("database is locked")
+	calls := 0
+	err := database.WithTransaction(context.Background(), db, func(tx *gorm.DB) error {
+		calls++
+		return lockedErr
+	}, database.WithRetryPolicy(fastPolicy))
+
+	assert.ErrorIs(t, err, lockedErr)
+	assert.Equal(t, fastPolicy.MaxAttempts, calls)
+}
+
+func TestWithTransaction_NestedDoesNotRetry(t *testing.T) {
+	// Given: a fn that fails with SQLITE_BUSY, called inside an outer transaction
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	calls := 0
+	err := database.WithTransaction(context.Background(), db, func(tx *gorm.DB) error {
+		// When
+		return database.WithTransaction(context.Background(), tx, func(inner *gorm.DB) error {
+			calls++
+			return errors.New("database is locked")
+		}, database.WithRetryPolicy(fastPolicy))
+	}, database.WithoutRetry())
+
+	// Then: only the outer caller may retry the whole transaction
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIsRetryable(t *testing.T) {
+	testCases := []struct {
+		name    string
+		dialect string
+		err     error
+		want    bool
+	}{
+		{"oracle deadlock", database.DialectOracle, &network.OracleError{ErrCode: 60}, true},
+		{"oracle serialization", database.DialectOracle, &network.OracleError{ErrCode: 8177}, true},
+		{"oracle unique violation", database.DialectOracle, &network.OracleError{ErrCode: 1}, false},
+		{"oracle connection lost", database.DialectOracle, &network.OracleError{ErrCode: 3113}, true},
+		{"oracle wrapped message", database.DialectOracle, errors.New("commit failed: ORA-08177: can't serialize access"), true},
+		{"sqlite busy", database.DialectSQLite, errors.New("database is locked"), true},
+		{"sqlite busy on oracle", database.DialectOracle, errors.New("database is locked"), false},
+		{"context canceled", database.DialectOracle, context.Canceled, false},
+		{"nil", database.DialectSQLite, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, database.IsRetryable(tc.dialect, tc.err))
+		})
+	}
+}
+
+func TestIsConnectionError(t *testing.T) {
+	testCases := []struct {
+		name    string
+		dialect string
+		err     error
+		want    bool
+	}{
+		{"bad conn", database.DialectSQLite, driver.ErrBadConn, true},
+		{"unexpected eof", database.DialectOracle, fmt.Errorf("commit: %w", io.ErrUnexpectedEOF), true},
+		{"oracle end-of-file", database.DialectOracle, &network.OracleError{ErrCode: 3113}, true},
+		{"oracle wrapped message", database.DialectOracle, errors.New("commit failed: ORA-03135: connection lost contact"), true},
+		{"oracle deadlock", database.DialectOracle, &network.OracleError{ErrCode: 60}, false},
+		{"sqlite busy", database.DialectSQLite, errors.New("database is locked"), false},
+		{"nil", database.DialectSQLite, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, database.IsConnectionError(tc.dialect, tc.err))
+		})
+	}
+}
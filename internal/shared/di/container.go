@@ -0,0 +1,280 @@
+// Package di resolves the application's components from their constructors.
+//
+// A provider is a constructor function; its parameters are its dependencies and its first result
+// is the type it provides, optionally followed by an error:
+//
+//	func NewMemberService(db *gorm.DB, memberRepository *MemberRepository) *MemberService
+//
+// Every type is built once, on first use. Validate checks the dependency graph (missing providers,
+// cycles) before anything is built, so wiring mistakes fail at startup instead of at the first request.
+package di
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var errorType = reflect.TypeFor[error]()
+
+// provider builds one type
+type provider struct {
+	fn     reflect.Value
+	params []reflect.Type
+	source string // where it was registered, for error messages (e.g. module name)
+
+	built bool
+	value reflect.Value
+}
+
+// Container holds the providers and the instances built from them
+type Container struct {
+	mu        sync.Mutex
+	providers map[reflect.Type]*provider
+	modules   []Module
+}
+
+// New creates an empty container
+func New() *Container {
+	return &Container{providers: map[reflect.Type]*provider{}}
+}
+
+// Provide registers constructors. It panics if a constructor is not a function
+// or its type is already provided; use Override to replace a provider.
+func (c *Container) Provide(constructors ...any) {
+	c.provide("", constructors, false)
+}
+
+// Supply registers ready-made values (e.g. *config.Config) under their dynamic type
+func (c *Container) Supply(values ...any) {
+	for _, value := range values {
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			panic("di: nil 값은 Supply 할 수 없습니다")
+		}
+		c.register(v.Type(), &provider{built: true, value: v, source: "supply"}, false)
+	}
+}
+
+// Override replaces the providers of the types the constructors return, e.g. a mock in tests.
+// It panics if a type has no provider yet, so a typo does not silently add a new one.
+//
+// Usage:
+//
+//	container.Override(func() token.Manager { return testutil.NewMockTokenManager() })
+func (c *Container) Override(constructors ...any) {
+	c.provide("override", constructors, true)
+}
+
+func (c *Container) provide(source string, constructors []any, override bool) {
+	for _, constructor := range constructors {
+		fn := reflect.ValueOf(constructor)
+		if fn.Kind() != reflect.Func {
+			panic(fmt.Sprintf("di: provider는 함수여야 합니다: %T", constructor))
+		}
+
+		fnType := fn.Type()
+		out, err := providedType(fnType)
+		if err != nil {
+			panic(fmt.Sprintf("di: %s: %v", fnType, err))
+		}
+
+		params := make([]reflect.Type, fnType.NumIn())
+		for i := range params {
+			params[i] = fnType.In(i)
+		}
+		c.register(out, &provider{fn: fn, params: params, source: source}, override)
+	}
+}
+
+func (c *Container) register(t reflect.Type, p *provider, override bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	existing, exists := c.providers[t]
+	switch {
+	case override && !exists:
+		panic(fmt.Sprintf("di: override 대상 provider가 없습니다: %s", t))
+	case !override && exists:
+		panic(fmt.Sprintf("di: 중복 provider: %s (기존: %s, 추가: %s)", t, sourceName(existing.source), sourceName(p.source)))
+	}
+	c.providers[t] = p
+}
+
+// providedType returns the type a constructor provides: func(...) T or func(...) (T, error)
+func providedType(fnType reflect.Type) (reflect.Type, error) {
+	if fnType.IsVariadic() {
+		return nil, errors.New("가변 인자 함수는 provider가 될 수 없습니다")
+	}
+	switch {
+	case fnType.NumOut() == 1 && fnType.Out(0) != errorType:
+		return fnType.Out(0), nil
+	case fnType.NumOut() == 2 && fnType.Out(0) != errorType && fnType.Out(1) == errorType:
+		return fnType.Out(0), nil
+	}
+	return nil, errors.New("반환 값은 T 또는 (T, error) 여야 합니다")
+}
+
+// Validate checks that every dependency of the providers and module routes has a provider
+// and that there are no dependency cycles. It builds nothing.
+func (c *Container) Validate() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var errs []error
+
+	// Sorted for stable error messages
+	types := make([]reflect.Type, 0, len(c.providers))
+	for t := range c.providers {
+		types = append(types, t)
+	}
+	slices.SortFunc(types, func(a, b reflect.Type) int { return strings.Compare(a.String(), b.String()) })
+
+	for _, t := range types {
+		for _, param := range c.providers[t].params {
+			if _, ok := c.providers[param]; !ok {
+				errs = append(errs, fmt.Errorf("provider 없음: %s (필요: %s)", param, t))
+			}
+		}
+	}
+	for _, module := range c.modules {
+		if module.Routes == nil {
+			continue
+		}
+		fnType := reflect.TypeOf(module.Routes)
+		for i := range fnType.NumIn() {
+			if _, ok := c.providers[fnType.In(i)]; !ok {
+				errs = append(errs, fmt.Errorf("provider 없음: %s (필요: %s 모듈 routes)", fnType.In(i), module.Name))
+			}
+		}
+	}
+
+	// Depth-first search; a type met again while still on the path closes a cycle
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[reflect.Type]int{}
+	var path []reflect.Type
+	var visit func(t reflect.Type)
+	visit = func(t reflect.Type) {
+		p, ok := c.providers[t]
+		if !ok || state[t] == done {
+			return
+		}
+		if state[t] == visiting {
+			start := slices.Index(path, t)
+			errs = append(errs, fmt.Errorf("순환 의존성: %s", formatPath(append(slices.Clone(path[start:]), t))))
+			return
+		}
+
+		state[t] = visiting
+		path = append(path, t)
+		for _, param := range p.params {
+			visit(param)
+		}
+		path = path[:len(path)-1]
+		state[t] = done
+	}
+	for _, t := range types {
+		visit(t)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Resolve returns the instance of T, building it and its dependencies on first use
+func Resolve[T any](c *Container) (T, error) {
+	var zero T
+	v, err := c.resolve(reflect.TypeFor[T]())
+	if err != nil {
+		return zero, err
+	}
+	return v.Interface().(T), nil
+}
+
+// Invoke calls fn with its parameters resolved from the container.
+// If fn returns an error as its last result, Invoke returns it.
+func (c *Container) Invoke(fn any) error {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Errorf("di: Invoke 대상은 함수여야 합니다: %T", fn)
+	}
+
+	args := make([]reflect.Value, v.Type().NumIn())
+	for i := range args {
+		arg, err := c.resolve(v.Type().In(i))
+		if err != nil {
+			return err
+		}
+		args[i] = arg
+	}
+
+	out := v.Call(args)
+	if n := len(out); n > 0 && v.Type().Out(n-1) == errorType && !out[n-1].IsNil() {
+		return out[n-1].Interface().(error)
+	}
+	return nil
+}
+
+func (c *Container) resolve(t reflect.Type) (reflect.Value, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.build(t, nil)
+}
+
+// build returns the instance of t; path holds the types being built to detect cycles
+// when Validate was skipped. Must be called with c.mu held.
+func (c *Container) build(t reflect.Type, path []reflect.Type) (reflect.Value, error) {
+	p, ok := c.providers[t]
+	if !ok {
+		if len(path) > 0 {
+			return reflect.Value{}, fmt.Errorf("provider 없음: %s (필요: %s)", t, path[len(path)-1])
+		}
+		return reflect.Value{}, fmt.Errorf("provider 없음: %s", t)
+	}
+	if p.built {
+		return p.value, nil
+	}
+	if slices.Contains(path, t) {
+		return reflect.Value{}, fmt.Errorf("순환 의존성: %s", formatPath(append(path, t)))
+	}
+
+	path = append(path, t)
+	args := make([]reflect.Value, len(p.params))
+	for i, param := range p.params {
+		arg, err := c.build(param, path)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		args[i] = arg
+	}
+
+	out := p.fn.Call(args)
+	if len(out) == 2 && !out[1].IsNil() {
+		return reflect.Value{}, fmt.Errorf("%s 생성 실패: %w", t, out[1].Interface().(error))
+	}
+
+	p.built = true
+	p.value = out[0]
+	return p.value, nil
+}
+
+func sourceName(source string) string {
+	if source == "" {
+		return "provide"
+	}
+	return source
+}
+
+func formatPath(path []reflect.Type) string {
+	names := make([]string, len(path))
+	for i, t := range path {
+		names[i] = t.String()
+	}
+	return strings.Join(names, " -> ")
+}
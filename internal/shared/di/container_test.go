@@ -0,0 +1,152 @@
+package di_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/di"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	config     struct{ name string }
+	repository struct{ cfg *config }
+	service    struct{ repo *repository }
+	handler    struct{ svc *service }
+)
+
+type greeter interface{ Greet() string }
+
+type english struct{}
+
+func (english) Greet() string { return "hello" }
+
+type korean struct{}
+
+func (korean) Greet() string { return "안녕하세요" }
+
+func newRepository(cfg *config) *repository { return &repository{cfg: cfg} }
+func newService(repo *repository) *service  { return &service{repo: repo} }
+func newHandler(svc *service) *handler      { return &handler{svc: svc} }
+
+func TestContainer_ResolvesOnce(t *testing.T) {
+	// Given
+	calls := 0
+	c := di.New()
+	c.Supply(&config{name: "test"})
+	c.Provide(newRepository, newHandler, func(repo *repository) *service {
+		calls++
+		return newService(repo)
+	})
+	require.NoError(t, c.Validate())
+
+	// When
+	h, err := di.Resolve[*handler](c)
+	require.NoError(t, err)
+	svc, err := di.Resolve[*service](c)
+	require.NoError(t, err)
+
+	// Then: one instance shared by every dependant
+	assert.Same(t, svc, h.svc)
+	assert.Equal(t, "test", h.svc.repo.cfg.name)
+	assert.Equal(t, 1, calls)
+}
+
+func TestContainer_ValidateReportsMissingProviders(t *testing.T) {
+	// Given: nobody provides the *config of *repository or the *handler of the routes
+	c := di.New()
+	c.Provide(newRepository, newService)
+	c.Install(di.Module{Name: "web", Routes: func(h *handler) {}})
+
+	// When
+	err := c.Validate()
+
+	// Then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "provider 없음: *di_test.config (필요: *di_test.repository)")
+	assert.Contains(t, err.Error(), "provider 없음: *di_test.handler (필요: web 모듈 routes)")
+}
+
+func TestContainer_ValidateReportsCycles(t *testing.T) {
+	// Given: service -> repository -> service
+	c := di.New()
+	c.Provide(newService, func(*service) *repository { return &repository{} })
+
+	// When
+	err := c.Validate()
+
+	// Then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "순환 의존성: *di_test.repository -> *di_test.service -> *di_test.repository")
+
+	_, err = di.Resolve[*service](c)
+	assert.ErrorContains(t, err, "순환 의존성")
+}
+
+func TestContainer_ConstructorError(t *testing.T) {
+	c := di.New()
+	c.Provide(func() (*config, error) { return nil, errors.New("boom") }, newRepository)
+
+	_, err := di.Resolve[*repository](c)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestContainer_Override(t *testing.T) {
+	// Given
+	c := di.New()
+	c.Provide(func() greeter { return english{} })
+
+	// When
+	c.Override(func() greeter { return korean{} })
+
+	// Then
+	g, err := di.Resolve[greeter](c)
+	require.NoError(t, err)
+	assert.Equal(t, "안녕하세요", g.Greet())
+}
+
+func TestContainer_ProvideMisuse(t *testing.T) {
+	c := di.New()
+	c.Provide(newRepository)
+
+	assert.Panics(t, func() { c.Provide(newRepository) }, "duplicate")
+	assert.Panics(t, func() { c.Provide("not a function") }, "not a function")
+	assert.Panics(t, func() { c.Provide(func() error { return nil }) }, "provides nothing")
+	assert.Panics(t, func() { c.Override(newService) }, "override without a provider")
+}
+
+func TestContainer_BuildRegistersRoutesInOrder(t *testing.T) {
+	// Given
+	var routes []string
+	c := di.New()
+	c.Supply(&config{name: "test"})
+	c.Install(
+		di.Module{Name: "repo", Providers: []any{newRepository}, Routes: func(*repository) { routes = append(routes, "repo") }},
+		di.Module{Name: "service", Providers: []any{newService, newHandler}, Routes: func(*handler) error {
+			routes = append(routes, "service")
+			return nil
+		}},
+	)
+
+	// When
+	err := c.Build()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"repo", "service"}, routes)
+}
+
+func TestContainer_BuildFailsBeforeRegisteringRoutes(t *testing.T) {
+	called := false
+	c := di.New()
+	c.Install(di.Module{Name: "repo", Providers: []any{newRepository}, Routes: func(*repository) { called = true }})
+
+	err := c.Build()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "의존성 구성 오류")
+	assert.False(t, called)
+}
@@ -0,0 +1,59 @@
+package di
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Module is one domain's contribution to the container: the constructors it provides
+// and a function registering its routes, called with its parameters resolved.
+//
+// Usage:
+//
+//	var Module = di.Module{
+//		Name:      "member",
+//		Providers: []any{NewMemberRepository, NewMemberService, NewMemberHandler},
+//		Routes:    func(api *route.API, h *MemberHandler) { api.Members.GET("/me", handler.Handle(h.GetMe)) },
+//	}
+type Module struct {
+	Name      string
+	Providers []any
+	Routes    any // func(deps...) or func(deps...) error; optional
+}
+
+// Install registers the modules' providers. It panics on a duplicate provider,
+// naming the module that registered it.
+func (c *Container) Install(modules ...Module) {
+	for _, module := range modules {
+		if module.Routes != nil && reflect.TypeOf(module.Routes).Kind() != reflect.Func {
+			panic(fmt.Sprintf("di: %s 모듈의 Routes는 함수여야 합니다: %T", module.Name, module.Routes))
+		}
+		c.provide(module.Name, module.Providers, false)
+
+		c.mu.Lock()
+		c.modules = append(c.modules, module)
+		c.mu.Unlock()
+	}
+}
+
+// Build validates the dependency graph and registers every installed module's routes
+// in installation order. Overrides must be applied before Build.
+func (c *Container) Build() error {
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("의존성 구성 오류: %w", err)
+	}
+
+	c.mu.Lock()
+	modules := append([]Module(nil), c.modules...)
+	c.mu.Unlock()
+
+	for _, module := range modules {
+		if module.Routes == nil {
+			continue
+		}
+		if err := c.Invoke(module.Routes); err != nil {
+			return fmt.Errorf("%s 모듈 route 등록 실패: %w", module.Name, err)
+		}
+	}
+	return nil
+}
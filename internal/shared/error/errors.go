@@ -3,15 +3,31 @@ package error
 import (
 	"errors"
 	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/i18n"
 )
 
 type DomainError interface {
 	error // Embed standard error interface
 	Info() string
+
+	// Details returns client-safe data rendered as "details" (nil if none)
+	Details() any
+	// Headers returns response headers to set with the error (nil if none)
+	Headers() http.Header
+
+	// WithDetails returns a copy carrying details; errors.Is still matches the original sentinel.
+	// details must be safe to show to clients, e.g. struct{ LockedUntil time.Time `json:"lockedUntil"` }
+	WithDetails(details any) DomainError
+	// WithHeader returns a copy that also sets the response header key (e.g. Retry-After)
+	WithHeader(key, value string) DomainError
 }
 
 type domainSentinel struct {
 	errInfo string
+	details any
+	headers http.Header
+	origin  *domainSentinel // sentinel this copy was derived from (nil for the sentinel itself)
 }
 
 func (e *domainSentinel) Error() string {
@@ -22,60 +38,219 @@ func (e *domainSentinel) Info() string {
 	return e.errInfo
 }
 
+func (e *domainSentinel) Details() any {
+	return e.details
+}
+
+func (e *domainSentinel) Headers() http.Header {
+	return e.headers
+}
+
+func (e *domainSentinel) WithDetails(details any) DomainError {
+	derived := e.derive()
+	derived.details = details
+	return derived
+}
+
+func (e *domainSentinel) WithHeader(key, value string) DomainError {
+	derived := e.derive()
+	derived.headers.Set(key, value)
+	return derived
+}
+
+// Is makes errors.Is(err, ErrX) true for copies derived from ErrX
+func (e *domainSentinel) Is(target error) bool {
+	t, ok := target.(*domainSentinel)
+	return ok && e.root() == t.root()
+}
+
+func (e *domainSentinel) derive() *domainSentinel {
+	headers := e.headers.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	return &domainSentinel{errInfo: e.errInfo, details: e.details, headers: headers, origin: e.root()}
+}
+
+func (e *domainSentinel) root() *domainSentinel {
+	if e.origin != nil {
+		return e.origin
+	}
+	return e
+}
+
 // ErrorResponse is the JSON response structure for errors
 type ErrorResponse struct {
 	Status  int    `json:"status"`
 	Code    string `json:"code"`
-	Message string `json:"message"` // client message
+	Message string `json:"message"` // client message, rendered from MessageKey per request locale
+
+	// Title is the generic message of the code, the same for every occurrence (problem+json title)
+	Title string `json:"-"`
+
+	// Details is client-safe data attached with DomainError.WithDetails
+	Details any `json:"details,omitempty"`
+
+	// MessageKey is the i18n catalog key for Message (see Localize)
+	MessageKey string `json:"-"`
+
+	// Errors lists every failed field of a validation error.
+	// Only rendered in problem+json mode so the legacy shape stays unchanged.
+	Errors []FieldError `json:"-"`
+
+	// Headers are set on the response (e.g. Retry-After) by handler.WriteError
+	Headers http.Header `json:"-"`
 }
 
 // Common errors
 var (
-	domainErrorResponses = map[string]ErrorResponse{}
-
 	// ValidationFailed indicates the request payload failed validation
 	ValidationFailed = ErrorResponse{
-		Status:  http.StatusBadRequest,
-		Code:    "ERROR-001", // METHOD_ARGUMENT_NOT_VALID
-		Message: "잘못된 요청입니다.",
+		Status:     http.StatusBadRequest,
+		Code:       "ERROR-001", // METHOD_ARGUMENT_NOT_VALID
+		MessageKey: "error.validation_failed",
 	}
 
 	// InvalidRequest indicates the request format is invalid (e.g., JSON parsing error)
 	InvalidRequest = ErrorResponse{
-		Status:  http.StatusBadRequest,
-		Code:    "ERROR-002", // INVALID_REQUEST
-		Message: "잘못된 요청 형식입니다.",
+		Status:     http.StatusBadRequest,
+		Code:       "ERROR-002", // INVALID_REQUEST
+		MessageKey: "error.invalid_request",
 	}
 
 	// InternalServerError indicates an unexpected server error
 	InternalServerError = ErrorResponse{
-		Status:  http.StatusInternalServerError,
-		Code:    "ERROR-003", // INTERNAL_SERVER_ERROR
-		Message: "서버 내부 오류가 발생했습니다.",
+		Status:     http.StatusInternalServerError,
+		Code:       "ERROR-003", // INTERNAL_SERVER_ERROR
+		MessageKey: "error.internal",
+	}
+
+	// RequestTimeout indicates the request deadline expired before processing finished
+	RequestTimeout = ErrorResponse{
+		Status:     http.StatusGatewayTimeout,
+		Code:       "ERROR-004", // REQUEST_TIMEOUT
+		MessageKey: "error.timeout",
+	}
+
+	// ClientClosedRequest indicates the client cancelled the request (nginx 499)
+	ClientClosedRequest = ErrorResponse{
+		Status:     StatusClientClosedRequest,
+		Code:       "ERROR-005", // CLIENT_CLOSED_REQUEST
+		MessageKey: "error.client_closed",
+	}
+
+	// PayloadTooLarge indicates the request body exceeds the route's size limit
+	PayloadTooLarge = ErrorResponse{
+		Status:     http.StatusRequestEntityTooLarge,
+		Code:       "ERROR-006", // PAYLOAD_TOO_LARGE
+		MessageKey: "error.payload_too_large",
+	}
+
+	// UnsupportedMediaType indicates an unsupported Content-Type or Content-Encoding
+	UnsupportedMediaType = ErrorResponse{
+		Status:     http.StatusUnsupportedMediaType,
+		Code:       "ERROR-007", // UNSUPPORTED_MEDIA_TYPE
+		MessageKey: "error.unsupported_media_type",
+	}
+
+	// IdempotencyInProgress indicates a request with the same Idempotency-Key is still being processed
+	IdempotencyInProgress = ErrorResponse{
+		Status:     http.StatusConflict,
+		Code:       "ERROR-008", // IDEMPOTENCY_IN_PROGRESS
+		MessageKey: "error.idempotency_in_progress",
+	}
+
+	// IdempotencyKeyReused indicates an Idempotency-Key reused with a different request payload
+	IdempotencyKeyReused = ErrorResponse{
+		Status:     http.StatusUnprocessableEntity,
+		Code:       "ERROR-009", // IDEMPOTENCY_KEY_REUSED
+		MessageKey: "error.idempotency_key_reused",
+	}
+
+	// ForceUpdateRequired indicates the client app is older than the minimum supported version
+	ForceUpdateRequired = ErrorResponse{
+		Status:     http.StatusUpgradeRequired,
+		Code:       "ERROR-010", // FORCE_UPDATE_REQUIRED
+		MessageKey: "error.force_update_required",
+	}
+
+	// UnderMaintenance indicates the route group is in maintenance mode
+	UnderMaintenance = ErrorResponse{
+		Status:     http.StatusServiceUnavailable,
+		Code:       "ERROR-011", // UNDER_MAINTENANCE
+		MessageKey: "error.maintenance",
+	}
+
+	// ReadOnlyMode indicates the route group only accepts read requests during maintenance
+	ReadOnlyMode = ErrorResponse{
+		Status:     http.StatusServiceUnavailable,
+		Code:       "ERROR-012", // READ_ONLY_MODE
+		MessageKey: "error.read_only",
+	}
+
+	// Forbidden indicates the caller may not access the resource (e.g. internal endpoints without the internal token)
+	Forbidden = ErrorResponse{
+		Status:     http.StatusForbidden,
+		Code:       "ERROR-013", // FORBIDDEN
+		MessageKey: "error.forbidden",
+	}
+
+	// NotFound indicates the resource does not exist (e.g. a route behind a disabled feature flag)
+	NotFound = ErrorResponse{
+		Status:     http.StatusNotFound,
+		Code:       "ERROR-014", // NOT_FOUND
+		MessageKey: "error.not_found",
 	}
 )
 
+// StatusClientClosedRequest is the non-standard status for requests cancelled by the client
+const StatusClientClosedRequest = 499
+
 // NewDomainError creates a sentinel error that can participate in error chains.
 func NewDomainError(errInfo string) DomainError {
 	return &domainSentinel{errInfo: errInfo}
 }
 
-// RegisterDomainErrorResponse registers a mapping between a domain error errInfo and a shared error response.
-func RegisterDomainErrorResponse(errInfo string, resp ErrorResponse) {
-	domainErrorResponses[errInfo] = resp
-}
-
 // ResolveDomainError converts a domain error into a shared error response if a mapping exists.
+// The first DomainError in the chain is used, so wrapped errors (fmt.Errorf("...: %w", err)) resolve too.
 func ResolveDomainError(err error) (ErrorResponse, bool) {
 	if err == nil {
 		return ErrorResponse{}, false
 	}
 
 	var domainErr DomainError
-	if errors.As(err, &domainErr) {
-		if resp, ok := domainErrorResponses[domainErr.Info()]; ok {
-			return resp, true
+	if !errors.As(err, &domainErr) {
+		return ErrorResponse{}, false
+	}
+
+	resp, ok := registry.lookup(domainErr.Info())
+	if !ok {
+		return ErrorResponse{}, false
+	}
+
+	resp.Details = domainErr.Details()
+	resp.Headers = domainErr.Headers().Clone()
+	return resp, true
+}
+
+// Localize renders Message, Title (and field error messages) for locale.
+// Validation errors use the first field message as Message, as the legacy shape shows only one.
+func Localize(resp ErrorResponse, locale string) ErrorResponse {
+	if resp.MessageKey != "" {
+		resp.Message = i18n.T(locale, resp.MessageKey)
+		resp.Title = resp.Message
+	}
+
+	if len(resp.Errors) > 0 {
+		fieldErrors := make([]FieldError, len(resp.Errors))
+		for i, fe := range resp.Errors {
+			if fe.MessageKey != "" {
+				fe.Message = i18n.T(locale, fe.MessageKey, "field", fe.Field, "param", fe.Param)
+			}
+			fieldErrors[i] = fe
 		}
+		resp.Errors = fieldErrors
+		resp.Message = fieldErrors[0].Message
 	}
-	return ErrorResponse{}, false
+	return resp
 }
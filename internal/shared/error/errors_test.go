@@ -0,0 +1,91 @@
+package error_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type lockedDetails struct {
+	LockedUntil time.Time `json:"lockedUntil"`
+}
+
+func TestResolveDomainError_DetailsAndHeadersThroughWrapping(t *testing.T) {
+	// Given
+	errLocked := sharedError.NewDomainError("TEST_ACCOUNT_LOCKED")
+	sharedError.RegisterDomainErrorResponse("TEST_ACCOUNT_LOCKED", sharedError.ErrorResponse{
+		Status:     http.StatusLocked,
+		Code:       "TEST-001",
+		MessageKey: "test.account_locked",
+	})
+
+	until := time.Date(2026, 1, 1, 12, 5, 0, 0, time.UTC)
+	err := fmt.Errorf("로그인 실패: %w", errLocked.WithDetails(lockedDetails{LockedUntil: until}).WithHeader("Retry-After", "30"))
+
+	// When
+	resp, ok := sharedError.ResolveDomainError(err)
+
+	// Then
+	require.True(t, ok)
+	assert.Equal(t, http.StatusLocked, resp.Status)
+	assert.Equal(t, lockedDetails{LockedUntil: until}, resp.Details)
+	assert.Equal(t, "30", resp.Headers.Get("Retry-After"))
+	assert.True(t, errors.Is(err, errLocked))
+
+	// The sentinel itself is unchanged
+	assert.Nil(t, errLocked.Details())
+	assert.Nil(t, errLocked.Headers())
+}
+
+func TestRegisterDomainErrorResponse_Duplicates(t *testing.T) {
+	resp := sharedError.ErrorResponse{Status: http.StatusConflict, Code: "TEST-002", MessageKey: "test.conflict"}
+	sharedError.RegisterDomainErrorResponse("TEST_CONFLICT", resp)
+
+	// Same errInfo twice
+	assert.Panics(t, func() {
+		sharedError.RegisterDomainErrorResponse("TEST_CONFLICT", resp)
+	})
+
+	// Same code with a different response
+	assert.Panics(t, func() {
+		sharedError.RegisterDomainErrorResponse("TEST_OTHER", sharedError.ErrorResponse{
+			Status: http.StatusBadRequest, Code: "TEST-002", MessageKey: "test.other",
+		})
+	})
+
+	// Same code with an identical response is allowed
+	assert.NotPanics(t, func() {
+		sharedError.RegisterDomainErrorResponse("TEST_CONFLICT_ALIAS", resp)
+	})
+}
+
+func TestRegisterDomainErrorResponse_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errInfo := fmt.Sprintf("TEST_CONCURRENT_%d", i)
+			sharedError.RegisterDomainErrorResponse(errInfo, sharedError.ErrorResponse{
+				Status: http.StatusBadRequest, Code: fmt.Sprintf("TEST-C%02d", i), MessageKey: "test.concurrent",
+			})
+			_, _ = sharedError.ResolveDomainError(sharedError.NewDomainError(errInfo))
+		}()
+	}
+	wg.Wait()
+
+	count := 0
+	for _, entry := range sharedError.RegisteredErrors() {
+		if entry.Response.MessageKey == "test.concurrent" {
+			count++
+		}
+	}
+	assert.Equal(t, 50, count)
+}
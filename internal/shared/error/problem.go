@@ -0,0 +1,100 @@
+package error
+
+import (
+	"mime"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	ContentTypeJSON    = "application/json; charset=utf-8"
+	ContentTypeProblem = "application/problem+json"
+)
+
+// Problem is an RFC 7807 problem details response.
+// Code, Details and Errors are extension members.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"` // request ID
+	Code     string       `json:"code"`
+	Details  any          `json:"details,omitempty"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes one failed field of a validation error
+type FieldError struct {
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+
+	// MessageKey is the i18n catalog key for Message, with {field} and {param} placeholders
+	MessageKey string `json:"-"`
+}
+
+// ProblemOptions configures how error responses are rendered
+type ProblemOptions struct {
+	Default  bool   // true: problem+json even without Accept negotiation
+	TypeBase string // Problem.Type prefix (e.g. https://api.example.com/problems); empty: about:blank
+}
+
+var problemOptions atomic.Pointer[ProblemOptions]
+
+func init() {
+	problemOptions.Store(&ProblemOptions{})
+}
+
+// SetProblemOptions sets the process-wide rendering options (called once at startup)
+func SetProblemOptions(opts ProblemOptions) {
+	problemOptions.Store(&opts)
+}
+
+// ToProblem converts resp into problem details for the request identified by instance.
+// Title is the code's generic message; a message specific to this occurrence goes to Detail.
+func ToProblem(resp ErrorResponse, instance string) Problem {
+	problemType := "about:blank"
+	if base := problemOptions.Load().TypeBase; base != "" {
+		problemType = strings.TrimRight(base, "/") + "/" + strings.ToLower(resp.Code)
+	}
+
+	title, detail := resp.Title, ""
+	if title == "" {
+		title = resp.Message
+	} else if resp.Message != title {
+		detail = resp.Message
+	}
+
+	return Problem{
+		Type:     problemType,
+		Title:    title,
+		Detail:   detail,
+		Status:   resp.Status,
+		Instance: instance,
+		Code:     resp.Code,
+		Details:  resp.Details,
+		Errors:   resp.Errors,
+	}
+}
+
+// Negotiate picks the error body and content type from the Accept header.
+// Clients that do not ask for application/problem+json keep the ErrorResponse shape
+// unless problem+json is the configured default.
+func Negotiate(resp ErrorResponse, accept, instance string) (contentType string, body any) {
+	if problemOptions.Load().Default || acceptsProblem(accept) {
+		return ContentTypeProblem, ToProblem(resp, instance)
+	}
+	return ContentTypeJSON, resp
+}
+
+func acceptsProblem(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == ContentTypeProblem {
+			return true
+		}
+	}
+	return false
+}
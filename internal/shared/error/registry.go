@@ -0,0 +1,98 @@
+package error
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// domainErrorRegistry maps domain error errInfo to its response.
+// Registrations normally happen in init(), but the registry is safe for concurrent use.
+type domainErrorRegistry struct {
+	mu        sync.RWMutex
+	responses map[string]ErrorResponse // errInfo -> response
+	codes     map[string]string        // code -> first errInfo registered with it
+}
+
+var registry = &domainErrorRegistry{
+	responses: map[string]ErrorResponse{},
+	codes:     map[string]string{},
+}
+
+// RegisteredError is one entry of the domain error registry
+type RegisteredError struct {
+	ErrInfo  string
+	Response ErrorResponse
+}
+
+// RegisterDomainErrorResponse registers a mapping between a domain error errInfo and a shared error response.
+// resp.MessageKey must exist in every i18n catalog (enforced by tests).
+//
+// It panics if errInfo is already registered, or if resp.Code is already used by a response
+// with a different status or message; several errInfos may share one identical response
+// (e.g. every invalid token case answers AUTH-000).
+func RegisterDomainErrorResponse(errInfo string, resp ErrorResponse) {
+	if err := registry.register(errInfo, resp); err != nil {
+		panic(err)
+	}
+}
+
+func (r *domainErrorRegistry) register(errInfo string, resp ErrorResponse) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.responses[errInfo]; exists {
+		return fmt.Errorf("domain error 중복 등록: errInfo=%s", errInfo)
+	}
+	if other, exists := r.codes[resp.Code]; exists {
+		prev := r.responses[other]
+		if prev.Status != resp.Status || prev.MessageKey != resp.MessageKey {
+			return fmt.Errorf("domain error 코드 충돌: code=%s errInfo=%s (이미 %s 에 다른 응답으로 등록됨)", resp.Code, errInfo, other)
+		}
+	} else {
+		r.codes[resp.Code] = errInfo
+	}
+
+	r.responses[errInfo] = resp
+	return nil
+}
+
+func (r *domainErrorRegistry) lookup(errInfo string) (ErrorResponse, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resp, ok := r.responses[errInfo]
+	return resp, ok
+}
+
+// RegisteredErrors returns every registered domain error sorted by code, then errInfo
+func RegisteredErrors() []RegisteredError {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	entries := make([]RegisteredError, 0, len(registry.responses))
+	for errInfo, resp := range registry.responses {
+		entries = append(entries, RegisteredError{ErrInfo: errInfo, Response: resp})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Response.Code != entries[j].Response.Code {
+			return entries[i].Response.Code < entries[j].Response.Code
+		}
+		return entries[i].ErrInfo < entries[j].ErrInfo
+	})
+	return entries
+}
+
+// MessageKeys returns the message keys of the common and registered domain error responses
+func MessageKeys() []string {
+	keys := []string{
+		ValidationFailed.MessageKey, InvalidRequest.MessageKey, InternalServerError.MessageKey,
+		RequestTimeout.MessageKey, ClientClosedRequest.MessageKey, PayloadTooLarge.MessageKey, UnsupportedMediaType.MessageKey,
+		IdempotencyInProgress.MessageKey, IdempotencyKeyReused.MessageKey, ForceUpdateRequired.MessageKey,
+		UnderMaintenance.MessageKey, ReadOnlyMode.MessageKey, Forbidden.MessageKey, NotFound.MessageKey,
+	}
+	for _, entry := range RegisteredErrors() {
+		keys = append(keys, entry.Response.MessageKey)
+	}
+	return keys
+}
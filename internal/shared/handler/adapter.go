@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+)
+
+// Func is a handler that returns its response value or an error.
+// Errors are rendered by middleware.ErrorHandler, so handlers never write error responses themselves.
+//
+// Usage:
+//
+//	func (h *MemberHandler) GetProfile(c *gin.Context) (any, error) {
+//	    return h.memberService.GetProfile(c.Request.Context(), memberID)
+//	}
+//
+//	router.GET("/me", handler.Handle(memberHandler.GetProfile))
+type Func func(c *gin.Context) (any, error)
+
+// Result sets the success status of a Func response (default 200 OK)
+type Result struct {
+	Status int
+	Body   any
+}
+
+// Created returns a 201 Created result
+func Created(body any) Result {
+	return Result{Status: http.StatusCreated, Body: body}
+}
+
+// NoContent returns a 204 No Content result
+func NoContent() Result {
+	return Result{Status: http.StatusNoContent}
+}
+
+// Handle adapts fn to a gin.HandlerFunc.
+// A returned error is added to the context and the chain aborted for middleware.ErrorHandler to render.
+func Handle(fn Func) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, err := fn(c)
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		switch v := value.(type) {
+		case Result:
+			if v.Body == nil {
+				c.Status(v.Status)
+				return
+			}
+			c.JSON(v.Status, v.Body)
+		default:
+			c.JSON(http.StatusOK, v)
+		}
+	}
+}
+
+// BindError marks a request binding failure (malformed body or failed validation)
+type BindError struct {
+	Err error
+}
+
+func (e *BindError) Error() string {
+	return "요청 바인딩 실패: " + e.Err.Error()
+}
+
+func (e *BindError) Unwrap() error {
+	return e.Err
+}
+
+// MediaTypeError reports a body sent with a Content-Type the endpoint does not accept
+type MediaTypeError struct {
+	ContentType string
+}
+
+func (e *MediaTypeError) Error() string {
+	return "지원하지 않는 Content-Type: " + e.ContentType
+}
+
+// BindErrorResponse returns the response for a binding failure:
+// 415 for a non-JSON body, 413 for a body over the size limit,
+// 400 with field errors for validation failures and 400 for malformed input.
+func BindErrorResponse(err error) sharedError.ErrorResponse {
+	var mediaTypeErr *MediaTypeError
+	if errors.As(err, &mediaTypeErr) {
+		return sharedError.UnsupportedMediaType
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return sharedError.PayloadTooLarge
+	}
+
+	if resp, ok := validator.ToErrorResponse(err); ok {
+		return *resp
+	}
+	return sharedError.InvalidRequest
+}
+
+// ShouldBindJSON binds and validates the JSON body, returning a *BindError on failure.
+// Use it in Func handlers; middleware.ErrorHandler answers 400 with the field errors.
+//
+// The body must be sent as application/json (or application/*+json).
+func ShouldBindJSON(c *gin.Context, obj any) error {
+	if err := requireJSON(c); err != nil {
+		return &BindError{Err: err}
+	}
+	if err := c.ShouldBindJSON(obj); err != nil {
+		return &BindError{Err: err}
+	}
+	return nil
+}
+
+// requireJSON rejects bodies whose Content-Type is not JSON
+func requireJSON(c *gin.Context) error {
+	contentType := c.ContentType()
+	if contentType == gin.MIMEJSON || (strings.HasPrefix(contentType, "application/") && strings.HasSuffix(contentType, "+json")) {
+		return nil
+	}
+	return &MediaTypeError{ContentType: contentType}
+}
+
+// ShouldBindQuery binds and validates query parameters, returning a *BindError on failure
+func ShouldBindQuery(c *gin.Context, obj any) error {
+	if err := c.ShouldBindQuery(obj); err != nil {
+		return &BindError{Err: err}
+	}
+	return nil
+}
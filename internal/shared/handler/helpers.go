@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"encoding/json"
 	"net/http"
 
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
 	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/i18n"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/validator"
 	"github.com/gin-gonic/gin"
 )
@@ -18,17 +21,12 @@ import (
 //	    return
 //	}
 func BindJSON(c *gin.Context, obj any) bool {
-	if err := c.ShouldBindJSON(obj); err != nil {
+	if err := ShouldBindJSON(c, obj); err != nil {
 		// Add error to context for middleware logging
 		c.Error(err)
 
-		// Check if it's a validation error
-		if resp, ok := validator.ToErrorResponse(err); ok {
-			c.JSON(http.StatusBadRequest, resp)
-		} else {
-			// JSON parsing error or other binding errors
-			c.JSON(sharedError.InvalidRequest.Status, sharedError.InvalidRequest)
-		}
+		// Validation, JSON parsing, Content-Type or body size error
+		WriteError(c, BindErrorResponse(err))
 		return false
 	}
 	return true
@@ -50,10 +48,10 @@ func BindQuery(c *gin.Context, obj any) bool {
 
 		// Check if it's a validation error
 		if resp, ok := validator.ToErrorResponse(err); ok {
-			c.JSON(http.StatusBadRequest, resp)
+			WriteError(c, *resp)
 		} else {
 			// Query parsing error or other binding errors
-			c.JSON(sharedError.ValidationFailed.Status, sharedError.ValidationFailed)
+			WriteError(c, sharedError.ValidationFailed)
 		}
 		return false
 	}
@@ -73,5 +71,43 @@ func RespondError(c *gin.Context, err error, errResp sharedError.ErrorResponse)
 	c.Error(err)
 
 	// Send error response
-	c.JSON(errResp.Status, errResp)
+	WriteError(c, errResp)
+}
+
+// WriteError writes errResp as application/problem+json when the client asks for it
+// (Accept header) or it is the configured default, and as ErrorResponse JSON otherwise.
+// Messages are rendered in the locale negotiated from Accept-Language.
+func WriteError(c *gin.Context, errResp sharedError.ErrorResponse) {
+	body := prepareError(c.Writer.Header(), c.Request, sharedContext.GetRequestID(c), errResp)
+	c.JSON(errResp.Status, body)
+}
+
+// RenderError writes errResp like WriteError but without a gin.Context, for writers that must not
+// touch the context (e.g. the enforcing timeout answering while the handler goroutine still runs).
+func RenderError(w http.ResponseWriter, req *http.Request, requestID string, errResp sharedError.ErrorResponse) {
+	body := prepareError(w.Header(), req, requestID, errResp)
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(errResp.Status)
+	_, _ = w.Write(data)
+}
+
+// prepareError localizes and negotiates errResp, sets the response headers and returns the body
+func prepareError(header http.Header, req *http.Request, requestID string, errResp sharedError.ErrorResponse) any {
+	locale := i18n.Match(req.Header.Get("Accept-Language"))
+	errResp = sharedError.Localize(errResp, locale)
+	contentType, body := sharedError.Negotiate(errResp, req.Header.Get("Accept"), requestID)
+
+	for key, values := range errResp.Headers {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Language", locale)
+	return body
 }
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package health
+
+import "context"
+
+// DiskChecker is not supported on this platform and always passes
+func DiskChecker(path string, minFree int64) Checker {
+	return CheckerFunc(func(ctx context.Context) error { return nil })
+}
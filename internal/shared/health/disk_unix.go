@@ -0,0 +1,25 @@
+//go:build unix
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+)
+
+// DiskChecker fails when the filesystem holding path has less than minFree bytes available
+func DiskChecker(path string, minFree int64) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(path, &stat); err != nil {
+			return fmt.Errorf("디스크 정보 조회 실패: %w", err)
+		}
+
+		free := int64(stat.Bavail) * int64(stat.Bsize)
+		if free < minFree {
+			return fmt.Errorf("디스크 여유 공간 부족: %d bytes (최소 %d)", free, minFree)
+		}
+		return nil
+	})
+}
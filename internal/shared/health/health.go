@@ -0,0 +1,266 @@
+// Package health runs the checks behind the liveness, readiness and startup probes.
+//
+//   - liveness: the process is running; dependencies are not checked so an outage does not restart every pod
+//   - readiness: the instance can serve traffic; fails while starting and during graceful shutdown
+//   - startup: initialization finished (database reachable, migrations applied)
+package health
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+)
+
+// Probe selects which checks run
+type Probe int
+
+const (
+	Liveness Probe = iota
+	Readiness
+	Startup
+)
+
+func (p Probe) String() string {
+	switch p {
+	case Liveness:
+		return "liveness"
+	case Readiness:
+		return "readiness"
+	case Startup:
+		return "startup"
+	}
+	return fmt.Sprintf("probe(%d)", int(p))
+}
+
+// Status of a check or a probe
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Reasons a probe fails without running its checks
+const (
+	ReasonStarting     = "starting"
+	ReasonShuttingDown = "shutting_down"
+)
+
+// Checker reports an unhealthy dependency by returning an error.
+// Check must honor ctx; the registry stops waiting when the check timeout expires.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc adapts a function to Checker
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Check is a named checker registered for some probes
+type Check struct {
+	Name    string
+	Checker Checker
+	Probes  []Probe       // probes running the check (default: Readiness, Startup)
+	Timeout time.Duration // 0: HEALTH_CHECK_TIMEOUT
+
+	// NonCritical checks are reported in the details but do not fail the probe.
+	// Use it for shared state every instance sees alike (e.g. outbox lag), where failing
+	// readiness would take the whole service out of the load balancer.
+	NonCritical bool
+}
+
+// Result is the outcome of one check
+type Result struct {
+	Status    string    `json:"status"`
+	LatencyMs int64     `json:"latency_ms"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Report is the outcome of a probe
+type Report struct {
+	Status string            `json:"status"`
+	Reason string            `json:"reason,omitempty"`
+	Checks map[string]Result `json:"checks,omitempty"`
+}
+
+// Healthy reports whether the probe passed
+func (r Report) Healthy() bool {
+	return r.Status == StatusUp
+}
+
+// entry is a registered check with its cached result.
+// mu is held while the check runs so concurrent probes share one run.
+type entry struct {
+	Check
+
+	mu       sync.Mutex
+	last     Result
+	cachedAt time.Time
+}
+
+// Registry holds the checks and the lifecycle state of the instance
+type Registry struct {
+	timeout  time.Duration
+	cacheTTL time.Duration
+
+	mu      sync.RWMutex
+	entries []*entry
+
+	started  atomic.Bool
+	draining atomic.Bool
+}
+
+// NewRegistry creates an empty registry using the check timeout and cache TTL of cfg
+func NewRegistry(cfg config.HealthConfig) *Registry {
+	return &Registry{
+		timeout:  cfg.CheckTimeout,
+		cacheTTL: cfg.CacheTTL,
+	}
+}
+
+// Register adds a check. Names must be unique.
+//
+// Usage:
+//
+//	registry.Register(health.Check{Name: "database", Checker: health.CheckerFunc(db.HealthCheck)})
+func (r *Registry) Register(check Check) {
+	if len(check.Probes) == 0 {
+		check.Probes = []Probe{Readiness, Startup}
+	}
+	if check.Timeout <= 0 {
+		check.Timeout = r.timeout
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, e := range r.entries {
+		if e.Name == check.Name {
+			panic(fmt.Sprintf("health: 중복 체크 등록 name=%s", check.Name))
+		}
+	}
+	r.entries = append(r.entries, &entry{Check: check})
+}
+
+// MarkStarted makes readiness and startup run their checks; until then they fail with "starting"
+func (r *Registry) MarkStarted() {
+	r.started.Store(true)
+}
+
+// StartDraining makes readiness fail from now on, so load balancers stop routing to this instance
+// before the server shuts down. Liveness is not affected.
+func (r *Registry) StartDraining() {
+	if !r.draining.Swap(true) {
+		slog.Info("Readiness 실패 전환 - 트래픽 drain 시작")
+	}
+}
+
+// Run runs the checks of probe in parallel and returns the combined report
+func (r *Registry) Run(ctx context.Context, probe Probe) Report {
+	if probe != Liveness {
+		if !r.started.Load() {
+			return Report{Status: StatusDown, Reason: ReasonStarting}
+		}
+		if probe == Readiness && r.draining.Load() {
+			return Report{Status: StatusDown, Reason: ReasonShuttingDown}
+		}
+	}
+
+	entries := r.entriesFor(probe)
+	results := make([]Result, len(entries))
+
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = r.result(ctx, e)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Checks: make(map[string]Result, len(entries))}
+	for i, e := range entries {
+		report.Checks[e.Name] = results[i]
+		if results[i].Status != StatusUp && !e.NonCritical {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+func (r *Registry) entriesFor(probe Probe) []*entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []*entry
+	for _, e := range r.entries {
+		if slices.Contains(e.Probes, probe) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// result returns the cached result of e or runs the check when the cache expired
+func (r *Registry) result(ctx context.Context, e *entry) Result {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.cachedAt.IsZero() && time.Since(e.cachedAt) < r.cacheTTL {
+		return e.last
+	}
+
+	result := runCheck(ctx, e.Check)
+	// Log state changes only; probes run every few seconds
+	switch {
+	case result.Status == StatusDown && e.last.Status != StatusDown:
+		slog.Warn("Health 체크 실패", "check", e.Name, "error", result.Error)
+	case result.Status == StatusUp && e.last.Status == StatusDown:
+		slog.Info("Health 체크 복구", "check", e.Name)
+	}
+
+	e.last = result
+	e.cachedAt = time.Now()
+	return result
+}
+
+// runCheck runs one check with its timeout. A check ignoring ctx is abandoned at the timeout.
+func runCheck(ctx context.Context, check Check) Result {
+	// Probe requests are short-lived; the check should not be cut short by a client disconnect
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), check.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if rec := recover(); rec != nil {
+				done <- fmt.Errorf("panic: %v", rec)
+			}
+		}()
+		done <- check.Checker.Check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("제한 시간 %s 초과", check.Timeout)
+	}
+
+	result := Result{Status: StatusUp, LatencyMs: time.Since(start).Milliseconds(), CheckedAt: start}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,133 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegistry(cacheTTL time.Duration) *health.Registry {
+	return health.NewRegistry(config.HealthConfig{CheckTimeout: 100 * time.Millisecond, CacheTTL: cacheTTL})
+}
+
+func passing(context.Context) error { return nil }
+
+func TestRegistry_Lifecycle(t *testing.T) {
+	// Given
+	registry := newRegistry(0)
+	registry.Register(health.Check{Name: "database", Checker: health.CheckerFunc(passing)})
+	ctx := context.Background()
+
+	// Before start: only liveness passes
+	assert.True(t, registry.Run(ctx, health.Liveness).Healthy())
+	assert.Equal(t, health.ReasonStarting, registry.Run(ctx, health.Readiness).Reason)
+	assert.Equal(t, health.ReasonStarting, registry.Run(ctx, health.Startup).Reason)
+
+	// Started
+	registry.MarkStarted()
+	assert.True(t, registry.Run(ctx, health.Readiness).Healthy())
+	assert.True(t, registry.Run(ctx, health.Startup).Healthy())
+
+	// Draining: readiness fails, liveness and startup are not affected
+	registry.StartDraining()
+	ready := registry.Run(ctx, health.Readiness)
+	assert.False(t, ready.Healthy())
+	assert.Equal(t, health.ReasonShuttingDown, ready.Reason)
+	assert.True(t, registry.Run(ctx, health.Liveness).Healthy())
+	assert.True(t, registry.Run(ctx, health.Startup).Healthy())
+}
+
+func TestRegistry_RunsChecksInParallelWithTimeout(t *testing.T) {
+	// Given
+	registry := newRegistry(0)
+	slow := func(ctx context.Context) error {
+		time.Sleep(80 * time.Millisecond)
+		return nil
+	}
+	registry.Register(health.Check{Name: "a", Checker: health.CheckerFunc(slow)})
+	registry.Register(health.Check{Name: "b", Checker: health.CheckerFunc(slow)})
+	registry.Register(health.Check{Name: "c", Checker: health.CheckerFunc(slow)})
+	registry.Register(health.Check{
+		Name:    "stuck",
+		Checker: health.CheckerFunc(func(ctx context.Context) error { select {} }),
+		Timeout: 50 * time.Millisecond,
+	})
+	registry.MarkStarted()
+
+	// When
+	start := time.Now()
+	report := registry.Run(context.Background(), health.Readiness)
+
+	// Then: bounded by the slowest check, not the sum
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+	assert.False(t, report.Healthy())
+	require.Len(t, report.Checks, 4)
+	assert.Equal(t, health.StatusUp, report.Checks["a"].Status)
+	assert.Equal(t, health.StatusDown, report.Checks["stuck"].Status)
+	assert.Contains(t, report.Checks["stuck"].Error, "50ms")
+}
+
+func TestRegistry_CachesResults(t *testing.T) {
+	// Given
+	registry := newRegistry(time.Minute)
+	var calls atomic.Int32
+	registry.Register(health.Check{Name: "database", Checker: health.CheckerFunc(func(context.Context) error {
+		calls.Add(1)
+		return errors.New("connection refused")
+	})})
+	registry.MarkStarted()
+
+	// When
+	for range 3 {
+		registry.Run(context.Background(), health.Readiness)
+	}
+
+	// Then
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRegistry_ProbesAndNonCritical(t *testing.T) {
+	// Given
+	registry := newRegistry(0)
+	failing := health.CheckerFunc(func(context.Context) error { return errors.New("behind") })
+	registry.Register(health.Check{Name: "migrations", Checker: failing, Probes: []health.Probe{health.Startup}})
+	registry.Register(health.Check{Name: "outbox", Checker: failing, Probes: []health.Probe{health.Readiness}, NonCritical: true})
+	registry.MarkStarted()
+
+	// When
+	ready := registry.Run(context.Background(), health.Readiness)
+	startup := registry.Run(context.Background(), health.Startup)
+
+	// Then
+	assert.True(t, ready.Healthy())
+	assert.Equal(t, health.StatusDown, ready.Checks["outbox"].Status)
+	assert.NotContains(t, ready.Checks, "migrations")
+	assert.False(t, startup.Healthy())
+}
+
+func TestRegistry_RecoversPanickingCheck(t *testing.T) {
+	registry := newRegistry(0)
+	registry.Register(health.Check{Name: "boom", Checker: health.CheckerFunc(func(context.Context) error { panic("boom") })})
+	registry.MarkStarted()
+
+	report := registry.Run(context.Background(), health.Readiness)
+
+	assert.False(t, report.Healthy())
+	assert.Contains(t, report.Checks["boom"].Error, "boom")
+}
+
+func TestRegistry_DuplicateNamePanics(t *testing.T) {
+	registry := newRegistry(0)
+	registry.Register(health.Check{Name: "database", Checker: health.CheckerFunc(passing)})
+
+	assert.Panics(t, func() {
+		registry.Register(health.Check{Name: "database", Checker: health.CheckerFunc(passing)})
+	})
+}
@@ -0,0 +1,115 @@
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// Supported locales. The first one is the default.
+const (
+	Korean  = "ko"
+	English = "en"
+
+	DefaultLocale = Korean
+)
+
+//go:embed locales/*.yaml
+var localeFiles embed.FS
+
+var (
+	// supported is ordered for the matcher: index 0 is the fallback
+	supported = []string{Korean, English}
+
+	// catalogs maps locale -> message key -> template
+	catalogs = map[string]map[string]string{}
+	matcher  language.Matcher
+)
+
+func init() {
+	tags := make([]language.Tag, 0, len(supported))
+	for _, locale := range supported {
+		data, err := localeFiles.ReadFile(path.Join("locales", locale+".yaml"))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: %s 메시지 파일 읽기 실패: %v", locale, err))
+		}
+
+		messages := map[string]string{}
+		if err := yaml.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: %s 메시지 파일 파싱 실패: %v", locale, err))
+		}
+
+		catalogs[locale] = messages
+		tags = append(tags, language.Make(locale))
+	}
+	matcher = language.NewMatcher(tags)
+}
+
+// Locales returns the supported locales, default first
+func Locales() []string {
+	return append([]string(nil), supported...)
+}
+
+// Has reports whether key has a translation for locale
+func Has(locale, key string) bool {
+	_, ok := catalogs[locale][key]
+	return ok
+}
+
+// Keys returns every message key defined for locale
+func Keys(locale string) []string {
+	keys := make([]string, 0, len(catalogs[locale]))
+	for key := range catalogs[locale] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Match returns the supported locale that best fits an Accept-Language header value
+func Match(acceptLanguage string) string {
+	if acceptLanguage == "" {
+		return DefaultLocale
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+	return supported[index]
+}
+
+// T returns the message for key in locale with {name} placeholders replaced by args.
+// Missing translations fall back to the default locale, then to the key itself.
+//
+// Usage:
+//
+//	i18n.T("en", "validation.min", "field", "password", "param", "8")
+func T(locale, key string, args ...string) string {
+	template, ok := catalogs[locale][key]
+	if !ok {
+		if template, ok = catalogs[DefaultLocale][key]; !ok {
+			return key
+		}
+	}
+
+	if len(args) == 0 {
+		return template
+	}
+
+	pairs := make([]string, 0, len(args))
+	for i := 0; i+1 < len(args); i += 2 {
+		pairs = append(pairs, "{"+args[i]+"}", args[i+1])
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
@@ -0,0 +1,41 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogs_DefineSameKeys(t *testing.T) {
+	defaultKeys := i18n.Keys(i18n.DefaultLocale)
+	assert.NotEmpty(t, defaultKeys)
+
+	for _, locale := range i18n.Locales() {
+		assert.Equal(t, defaultKeys, i18n.Keys(locale), "locale %s", locale)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{"", i18n.DefaultLocale},
+		{"en-US,en;q=0.9", i18n.English},
+		{"ko-KR", i18n.Korean},
+		{"fr-FR, en;q=0.5", i18n.English},
+		{"ja-JP", i18n.DefaultLocale},
+		{"not a language", i18n.DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, i18n.Match(tt.acceptLanguage), tt.acceptLanguage)
+	}
+}
+
+func TestT_ReplacesPlaceholders(t *testing.T) {
+	assert.Equal(t, "password must be at least 8 characters.",
+		i18n.T(i18n.English, "validation.min", "field", "password", "param", "8"))
+	assert.Equal(t, "unknown.key", i18n.T(i18n.English, "unknown.key"))
+}
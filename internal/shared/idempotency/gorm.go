@@ -0,0 +1,108 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"gorm.io/gorm"
+)
+
+// GormStore keeps records in the idempotency_record table, shared by all replicas.
+// The (scope, idempotency_key) primary key makes Reserve atomic.
+type GormStore struct {
+	db *gorm.DB
+}
+
+// NewGormStore creates a database backed store
+func NewGormStore(db *gorm.DB) *GormStore {
+	return &GormStore{db: db}
+}
+
+func (s *GormStore) Reserve(ctx context.Context, rec Record) (*Record, error) {
+	db := s.db.WithContext(ctx)
+
+	// An expired record no longer blocks the key
+	if err := db.Where("scope = ? AND idempotency_key = ? AND expires_at < ?", rec.Scope, rec.Key, time.Now().UTC()).
+		Delete(&model.IdempotencyRecord{}).Error; err != nil {
+		return nil, fmt.Errorf("만료된 idempotency key 삭제 실패: %w", err)
+	}
+
+	err := db.Create(&model.IdempotencyRecord{
+		Scope:       rec.Scope,
+		Key:         rec.Key,
+		RequestHash: rec.RequestHash,
+		ExpiresAt:   rec.ExpiresAt.UTC(),
+	}).Error
+	if err == nil {
+		return nil, nil
+	}
+
+	// A concurrent request inserted first (unique violation) - return its record
+	var existing model.IdempotencyRecord
+	if findErr := db.Where("scope = ? AND idempotency_key = ?", rec.Scope, rec.Key).Take(&existing).Error; findErr == nil {
+		return toRecord(existing)
+	}
+	return nil, fmt.Errorf("idempotency key 예약 실패: %w", err)
+}
+
+func (s *GormStore) Complete(ctx context.Context, rec Record) error {
+	header, err := json.Marshal(rec.Header)
+	if err != nil {
+		return fmt.Errorf("idempotency 응답 헤더 직렬화 실패: %w", err)
+	}
+
+	err = s.db.WithContext(ctx).
+		Model(&model.IdempotencyRecord{}).
+		Where("scope = ? AND idempotency_key = ?", rec.Scope, rec.Key).
+		Updates(map[string]any{
+			"status":     rec.Status,
+			"header":     string(header),
+			"body":       string(rec.Body),
+			"expires_at": rec.ExpiresAt.UTC(),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("idempotency 응답 저장 실패: %w", err)
+	}
+	return nil
+}
+
+func (s *GormStore) Release(ctx context.Context, scope, key string) error {
+	err := s.db.WithContext(ctx).
+		Where("scope = ? AND idempotency_key = ? AND status = 0", scope, key).
+		Delete(&model.IdempotencyRecord{}).Error
+	if err != nil {
+		return fmt.Errorf("idempotency key 해제 실패: %w", err)
+	}
+	return nil
+}
+
+// PurgeExpired deletes expired records and returns how many were removed
+func PurgeExpired(ctx context.Context, db *gorm.DB) (int64, error) {
+	result := db.WithContext(ctx).
+		Where("expires_at < ?", time.Now().UTC()).
+		Delete(&model.IdempotencyRecord{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("만료된 idempotency key 삭제 실패: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func toRecord(row model.IdempotencyRecord) (*Record, error) {
+	rec := &Record{
+		Scope:       row.Scope,
+		Key:         row.Key,
+		RequestHash: row.RequestHash,
+		Status:      row.Status,
+		Body:        []byte(row.Body),
+		ExpiresAt:   row.ExpiresAt,
+	}
+	if row.Header != "" {
+		if err := json.Unmarshal([]byte(row.Header), &rec.Header); err != nil {
+			return nil, fmt.Errorf("idempotency 응답 헤더 해석 실패: %w", err)
+		}
+	}
+	return rec, nil
+}
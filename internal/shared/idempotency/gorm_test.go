@@ -0,0 +1,107 @@
+package idempotency_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/idempotency"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGormStore_Lifecycle(t *testing.T) {
+	// Given
+	db := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, db)
+	store := idempotency.NewGormStore(db)
+	ctx := context.Background()
+	rec := idempotency.Record{
+		Scope:       "1",
+		Key:         "key-1",
+		RequestHash: idempotency.HashRequest(http.MethodPost, "/posts", []byte(`{"title":"a"}`)),
+		ExpiresAt:   time.Now().Add(time.Minute),
+	}
+
+	// When: first reservation
+	existing, err := store.Reserve(ctx, rec)
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// When: duplicate while in flight
+	existing, err = store.Reserve(ctx, rec)
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.True(t, existing.InFlight())
+
+	// When: completed
+	rec.Status = http.StatusCreated
+	rec.Header = http.Header{"Content-Type": {"application/json"}}
+	rec.Body = []byte(`{"id":1}`)
+	rec.ExpiresAt = time.Now().Add(time.Hour)
+	require.NoError(t, store.Complete(ctx, rec))
+	existing, err = store.Reserve(ctx, rec)
+
+	// Then: the stored response is returned
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, http.StatusCreated, existing.Status)
+	assert.Equal(t, "application/json", existing.Header.Get("Content-Type"))
+	assert.Equal(t, `{"id":1}`, string(existing.Body))
+	assert.Equal(t, rec.RequestHash, existing.RequestHash)
+}
+
+func TestGormStore_ReleaseAndExpiry(t *testing.T) {
+	// Given
+	db := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, db)
+	store := idempotency.NewGormStore(db)
+	ctx := context.Background()
+	rec := idempotency.Record{Scope: "anonymous", Key: "key-1", RequestHash: "h", ExpiresAt: time.Now().Add(time.Minute)}
+
+	// When: released reservation can be taken again
+	_, err := store.Reserve(ctx, rec)
+	require.NoError(t, err)
+	require.NoError(t, store.Release(ctx, rec.Scope, rec.Key))
+	existing, err := store.Reserve(ctx, rec)
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// When: an expired record no longer blocks the key
+	rec.Status = http.StatusOK
+	rec.ExpiresAt = time.Now().Add(-time.Second)
+	require.NoError(t, store.Complete(ctx, rec))
+	rec.ExpiresAt = time.Now().Add(time.Minute)
+	existing, err = store.Reserve(ctx, rec)
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+}
+
+func TestPurgeExpired(t *testing.T) {
+	// Given
+	db := testutil.SetupTestDB(t)
+	defer testutil.CleanupTestDB(t, db)
+	store := idempotency.NewGormStore(db)
+	ctx := context.Background()
+	_, err := store.Reserve(ctx, idempotency.Record{Scope: "1", Key: "old", RequestHash: "h", ExpiresAt: time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+	_, err = store.Reserve(ctx, idempotency.Record{Scope: "1", Key: "new", RequestHash: "h", ExpiresAt: time.Now().Add(time.Minute)})
+	require.NoError(t, err)
+
+	// When
+	deleted, err := idempotency.PurgeExpired(ctx, db)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
@@ -0,0 +1,60 @@
+// Package idempotency stores responses of requests sent with an Idempotency-Key header
+// so retries of the same request get the original response instead of running twice.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// Header is the request header carrying the client generated key (e.g. a UUID)
+const Header = "Idempotency-Key"
+
+// ReplayedHeader marks a response replayed from the store
+const ReplayedHeader = "Idempotent-Replayed"
+
+// MaxKeyLength is the longest accepted key
+const MaxKeyLength = 255
+
+// Record is the stored state of one key
+type Record struct {
+	Scope       string // member ID, "anonymous" for unauthenticated requests
+	Key         string
+	RequestHash string      // see HashRequest
+	Status      int         // 0 while the first request is still in flight
+	Header      http.Header // response headers to replay
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// InFlight reports whether the first request with this key has not finished yet
+func (r *Record) InFlight() bool {
+	return r.Status == 0
+}
+
+// Store persists records. Reserve must be atomic: of two concurrent requests with the
+// same scope and key exactly one reserves it.
+type Store interface {
+	// Reserve creates an in-flight record unless an unexpired one exists for (scope, key).
+	// It returns nil when reserved, otherwise the existing record.
+	Reserve(ctx context.Context, rec Record) (*Record, error)
+	// Complete stores the response of a reserved key and extends it to rec.ExpiresAt
+	Complete(ctx context.Context, rec Record) error
+	// Release deletes an in-flight record so the request can be retried
+	Release(ctx context.Context, scope, key string) error
+}
+
+// HashRequest fingerprints a request so a key reused with a different payload is detected.
+// uri is the path with its query string (URL.RequestURI()).
+func HashRequest(method, uri string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(uri))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,85 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryPurgeInterval is how often Reserve sweeps expired records
+const memoryPurgeInterval = time.Minute
+
+// MemoryStore keeps records in process memory.
+// Use it for tests and single-instance deployments; replicas do not share keys.
+type MemoryStore struct {
+	mu        sync.Mutex
+	records   map[storeKey]Record
+	lastPurge time.Time
+}
+
+type storeKey struct {
+	scope, key string
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[storeKey]Record)}
+}
+
+func (s *MemoryStore) Reserve(_ context.Context, rec Record) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.lastPurge) > memoryPurgeInterval {
+		s.purgeLocked()
+	}
+
+	k := storeKey{rec.Scope, rec.Key}
+	if existing, ok := s.records[k]; ok && time.Now().Before(existing.ExpiresAt) {
+		return &existing, nil
+	}
+
+	rec.Status = 0
+	s.records[k] = rec
+	return nil, nil
+}
+
+func (s *MemoryStore) Complete(_ context.Context, rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[storeKey{rec.Scope, rec.Key}] = rec
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, scope, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := storeKey{scope, key}
+	if existing, ok := s.records[k]; ok && existing.InFlight() {
+		delete(s.records, k)
+	}
+	return nil
+}
+
+// Purge deletes expired records and returns how many were removed
+func (s *MemoryStore) Purge() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.purgeLocked()
+}
+
+func (s *MemoryStore) purgeLocked() int {
+	now := time.Now()
+	s.lastPurge = now
+	deleted := 0
+	for k, rec := range s.records {
+		if !now.Before(rec.ExpiresAt) {
+			delete(s.records, k)
+			deleted++
+		}
+	}
+	return deleted
+}
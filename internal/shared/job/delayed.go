@@ -0,0 +1,161 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"gorm.io/gorm"
+)
+
+// poll claims due delayed jobs and submits them to the worker pool
+func (s *Scheduler) poll() {
+	defer close(s.pollerDone)
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		jobs, err := s.claim(s.ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			s.logger.Error("지연 Job 조회 실패", "error", err)
+		}
+		for i := range jobs {
+			s.submit(s.delayedTask(jobs[i]))
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// claim locks due jobs (pending, or running with an expired lock) and marks them RUNNING
+func (s *Scheduler) claim(ctx context.Context) ([]model.ScheduledJob, error) {
+	var jobs []model.ScheduledJob
+
+	err := database.WithTransaction(ctx, s.db, func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		query := tx.
+			Model(&model.ScheduledJob{}).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)",
+				model.JobStatusPending, now, model.JobStatusRunning, now).
+			Order("run_at")
+
+		var err error
+		jobs, err = database.Fetch[model.ScheduledJob](tx, query, s.cfg.Workers)
+		if err != nil {
+			return fmt.Errorf("지연 Job 조회 실패: %w", err)
+		}
+
+		lockedUntil := now.Add(s.cfg.LeaseTTL)
+		for i := range jobs {
+			jobs[i].Status = model.JobStatusRunning
+			jobs[i].Attempts++
+			jobs[i].LockedBy = s.instanceID
+			jobs[i].LockedUntil = &lockedUntil
+			if err := tx.Save(&jobs[i]).Error; err != nil {
+				return fmt.Errorf("지연 Job 잠금 실패: id=%d %w", jobs[i].ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// delayedTask wraps a claimed job row into a worker task
+func (s *Scheduler) delayedTask(row model.ScheduledJob) task {
+	return task{
+		name: row.Name,
+		run: func(ctx context.Context) error {
+			handler, ok := s.handlers[row.Name]
+			if !ok {
+				return fmt.Errorf("등록된 job 핸들러가 없습니다: name=%s", row.Name)
+			}
+			return handler(ctx, []byte(row.Payload))
+		},
+		renew: func(ctx context.Context) (bool, error) {
+			return s.extendLock(ctx, row.ID)
+		},
+		finish: func(ctx context.Context, err error) {
+			s.complete(ctx, row, err)
+		},
+		release: func(ctx context.Context) {
+			// Never started: give the attempt back and unlock for another replica
+			s.update(ctx, row.ID, map[string]any{
+				"status":       model.JobStatusPending,
+				"attempts":     row.Attempts - 1,
+				"locked_by":    "",
+				"locked_until": nil,
+			})
+		},
+	}
+}
+
+// complete records the outcome of a delayed job
+func (s *Scheduler) complete(ctx context.Context, row model.ScheduledJob, runErr error) {
+	now := time.Now().UTC()
+
+	if runErr == nil {
+		s.update(ctx, row.ID, map[string]any{
+			"status":       model.JobStatusDone,
+			"finished_at":  now,
+			"locked_until": nil,
+			"last_error":   "",
+		})
+		return
+	}
+
+	lastError := database.Truncate(runErr.Error(), database.MaxLastErrorLength)
+	if row.Attempts >= s.cfg.MaxAttempts {
+		s.logger.Error("지연 Job 재시도 한도 초과", "job", row.Name, "id", row.ID, "attempts", row.Attempts)
+		s.update(ctx, row.ID, map[string]any{
+			"status":       model.JobStatusFailed,
+			"finished_at":  now,
+			"locked_until": nil,
+			"last_error":   lastError,
+		})
+		return
+	}
+
+	s.update(ctx, row.ID, map[string]any{
+		"status":       model.JobStatusPending,
+		"run_at":       now.Add(s.cfg.RetryDelay * time.Duration(row.Attempts)),
+		"locked_until": nil,
+		"last_error":   lastError,
+	})
+}
+
+// extendLock moves locked_until of a running job forward. Returns false when the lock was lost.
+func (s *Scheduler) extendLock(ctx context.Context, id uint64) (bool, error) {
+	result := s.db.WithContext(ctx).
+		Model(&model.ScheduledJob{}).
+		Where("id = ? AND status = ? AND locked_by = ?", id, model.JobStatusRunning, s.instanceID).
+		Update("locked_until", time.Now().UTC().Add(s.cfg.LeaseTTL))
+	if result.Error != nil {
+		return false, fmt.Errorf("지연 Job 잠금 연장 실패: id=%d %w", id, result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// update applies changes to a job row owned by this instance
+func (s *Scheduler) update(ctx context.Context, id uint64, changes map[string]any) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.db.WithContext(ctx).
+		Model(&model.ScheduledJob{}).
+		Where("id = ? AND locked_by = ?", id, s.instanceID).
+		Updates(changes).Error
+	if err != nil {
+		s.logger.Error("지연 Job 상태 저장 실패", "id", id, "error", err)
+	}
+}
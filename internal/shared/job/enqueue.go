@@ -0,0 +1,51 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"gorm.io/gorm"
+)
+
+// Enqueue persists a one-off job that runs at runAt on any replica.
+// Pass the surrounding transaction as db so the job only exists if the business change commits.
+//
+// Usage:
+//
+//	err := job.Enqueue(ctx, tx, "prayer.remind", ReminderPayload{PrayerID: id}, time.Now().Add(time.Hour))
+func Enqueue(ctx context.Context, db *gorm.DB, name string, payload any, runAt time.Time) error {
+	var data []byte
+	if payload != nil {
+		var err error
+		if data, err = json.Marshal(payload); err != nil {
+			return fmt.Errorf("job payload 직렬화 실패: name=%s %w", name, err)
+		}
+	}
+
+	row := &model.ScheduledJob{
+		Name:    name,
+		Payload: string(data),
+		Status:  model.JobStatusPending,
+		RunAt:   runAt.UTC(),
+	}
+
+	if err := db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("job 저장 실패: name=%s %w", name, err)
+	}
+	return nil
+}
+
+// PurgeFinished deletes jobs completed before the retention window.
+// Failed jobs are kept for manual inspection.
+func PurgeFinished(ctx context.Context, db *gorm.DB, retention time.Duration) (int64, error) {
+	result := db.WithContext(ctx).
+		Where("status = ? AND finished_at < ?", model.JobStatusDone, time.Now().UTC().Add(-retention)).
+		Delete(&model.ScheduledJob{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("완료된 job 삭제 실패: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,58 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"gorm.io/gorm"
+)
+
+// acquireLease takes or renews the named lease for holder until now+ttl.
+// Returns false when another replica holds an unexpired lease.
+func acquireLease(ctx context.Context, db *gorm.DB, name, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(ttl)
+
+	// Renew our own lease or take over an expired one (atomic conditional update)
+	result := db.WithContext(ctx).
+		Model(&model.JobLease{}).
+		Where("name = ? AND (holder = ? OR expires_at < ?)", name, holder, now).
+		Updates(map[string]any{"holder": holder, "expires_at": expiresAt})
+	if result.Error != nil {
+		return false, fmt.Errorf("lease 갱신 실패: name=%s %w", name, result.Error)
+	}
+	if result.RowsAffected == 1 {
+		return true, nil
+	}
+
+	// No row updated: the lease does not exist yet or is held by another replica
+	err := db.WithContext(ctx).Create(&model.JobLease{
+		Name:      name,
+		Holder:    holder,
+		ExpiresAt: expiresAt,
+	}).Error
+	if err == nil {
+		return true, nil
+	}
+
+	// A concurrent replica inserted first (unique violation) - not an error
+	var count int64
+	if countErr := db.WithContext(ctx).Model(&model.JobLease{}).Where("name = ?", name).Count(&count).Error; countErr == nil && count > 0 {
+		return false, nil
+	}
+	return false, fmt.Errorf("lease 생성 실패: name=%s %w", name, err)
+}
+
+// releaseLease expires the named lease if holder still holds it, so another replica does not wait for the TTL
+func releaseLease(ctx context.Context, db *gorm.DB, name, holder string) error {
+	err := db.WithContext(ctx).
+		Model(&model.JobLease{}).
+		Where("name = ? AND holder = ?", name, holder).
+		Update("expires_at", time.Now().UTC()).Error
+	if err != nil {
+		return fmt.Errorf("lease 반환 실패: name=%s %w", name, err)
+	}
+	return nil
+}
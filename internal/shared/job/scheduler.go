@@ -0,0 +1,319 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
+)
+
+// Func is a periodic job body
+type Func func(ctx context.Context) error
+
+// Handler processes a persisted one-off job payload (JSON).
+// Delivery is at-least-once, so handlers must be idempotent.
+type Handler func(ctx context.Context, payload []byte) error
+
+// task is a unit of work executed by the worker pool
+type task struct {
+	name    string
+	run     func(ctx context.Context) error
+	finish  func(ctx context.Context, err error)    // optional: record the outcome
+	release func(ctx context.Context)               // optional: hand back work that was never started
+	renew   func(ctx context.Context) (bool, error) // optional: extend the lease while running, false when lost
+}
+
+// Scheduler runs cron-style schedules and persisted delayed jobs on a bounded worker pool.
+// Each schedule fires on a single replica at a time using a DB lease (leader election per schedule).
+// Leases and delayed job locks are extended while the job runs. A cron lease is kept until its TTL runs out
+// after the run, so a replica that fires the same tick later (clock skew) sees it as taken.
+type Scheduler struct {
+	db         *gorm.DB
+	cfg        config.JobConfig
+	instanceID string
+	logger     *slog.Logger
+
+	cron     *cron.Cron
+	handlers map[string]Handler
+	running  sync.Map // schedule name -> struct{} (prevents overlapping runs on this replica)
+
+	tasks      chan task
+	ctx        context.Context    // cancelled when polling/firing must stop
+	cancel     context.CancelFunc //
+	runCtx     context.Context    // passed to job bodies, cancelled only when Stop times out
+	runCancel  context.CancelFunc //
+	stopping   atomic.Bool
+	workers    sync.WaitGroup
+	pollerDone chan struct{}
+	stopOnce   sync.Once
+}
+
+// NewScheduler creates a new job scheduler
+func NewScheduler(db *gorm.DB, cfg config.JobConfig) *Scheduler {
+	hostname, _ := os.Hostname()
+
+	return &Scheduler{
+		db:         db,
+		cfg:        cfg,
+		instanceID: fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8]),
+		logger:     slog.With("component", "job"),
+		cron:       cron.New(cron.WithLocation(time.UTC)),
+		handlers:   make(map[string]Handler),
+		tasks:      make(chan task, cfg.Workers),
+	}
+}
+
+// Schedule registers a periodic job using a standard 5-field cron spec (UTC), e.g. "0 3 * * *".
+// Descriptors such as "@every 10m" and "@daily" are also accepted.
+func (s *Scheduler) Schedule(name, spec string, fn Func) error {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("잘못된 cron 표현식: name=%s spec=%q %w", name, spec, err)
+	}
+
+	s.cron.Schedule(schedule, cron.FuncJob(func() {
+		s.fire(name, fn)
+	}))
+
+	s.logger.Info("주기 Job 등록", "job", name, "spec", spec)
+	return nil
+}
+
+// Handle registers the handler for persisted one-off jobs with the given name (see Enqueue)
+func (s *Scheduler) Handle(name string, handler Handler) {
+	if _, exists := s.handlers[name]; exists {
+		panic(fmt.Sprintf("job: 중복 핸들러 등록 name=%s", name))
+	}
+	s.handlers[name] = handler
+}
+
+// Start launches the worker pool, the cron ticker and the delayed job poller
+func (s *Scheduler) Start(ctx context.Context) {
+	s.ctx, s.cancel = context.WithCancel(ctx)
+	s.runCtx, s.runCancel = context.WithCancel(context.WithoutCancel(ctx))
+	s.pollerDone = make(chan struct{})
+
+	for i := 0; i < s.cfg.Workers; i++ {
+		s.workers.Add(1)
+		go s.worker()
+	}
+
+	go s.poll()
+	s.cron.Start()
+
+	s.logger.Info("Job 스케줄러 시작",
+		"instance_id", s.instanceID,
+		"workers", s.cfg.Workers,
+		"poll_interval", s.cfg.PollInterval.String(),
+	)
+}
+
+// Stop stops firing new work, lets running jobs finish until ctx expires, then cancels them.
+// Queued work that never started is released for another replica.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+
+	var err error
+	s.stopOnce.Do(func() {
+		s.logger.Info("Job 스케줄러 종료 중...")
+		s.stopping.Store(true)
+
+		// 1. No new firings or polling. Cancel first: a firing blocked in submit
+		// while every worker is busy only returns once s.ctx is done.
+		cronDone := s.cron.Stop().Done()
+		s.cancel()
+		<-cronDone
+		<-s.pollerDone
+
+		// 2. Drain workers
+		close(s.tasks)
+		done := make(chan struct{})
+		go func() {
+			s.workers.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			s.logger.Info("Job 스케줄러 종료 완료")
+		case <-ctx.Done():
+			// 3. Deadline reached: cancel running jobs and give them a moment to return
+			s.runCancel()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+			}
+			err = fmt.Errorf("실행 중인 job 종료 대기 시간 초과: %w", ctx.Err())
+		}
+		s.runCancel()
+	})
+	return err
+}
+
+// fire is called by cron on every tick of a schedule
+func (s *Scheduler) fire(name string, fn Func) {
+	if s.stopping.Load() {
+		return
+	}
+
+	// Skip if the previous run on this replica is still in progress
+	if _, busy := s.running.LoadOrStore(name, struct{}{}); busy {
+		s.logger.Warn("이전 실행이 끝나지 않아 건너뜁니다", "job", name)
+		return
+	}
+
+	leaseName := "cron:" + name
+	acquired, err := acquireLease(s.ctx, s.db, leaseName, s.instanceID, s.cfg.LeaseTTL)
+	if err != nil || !acquired {
+		s.running.Delete(name)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			s.logger.Error("Job lease 획득 실패", "job", name, "error", err)
+		} else if err == nil {
+			s.logger.Debug("다른 인스턴스가 실행 중이어서 건너뜁니다", "job", name)
+		}
+		return
+	}
+
+	s.submit(task{
+		name: name,
+		run: func(ctx context.Context) error {
+			return fn(ctx)
+		},
+		finish: func(ctx context.Context, err error) {
+			s.running.Delete(name)
+		},
+		release: func(ctx context.Context) {
+			// Never started: let another replica run this tick
+			s.running.Delete(name)
+			s.releaseLease(ctx, leaseName)
+		},
+		renew: func(ctx context.Context) (bool, error) {
+			return acquireLease(ctx, s.db, leaseName, s.instanceID, s.cfg.LeaseTTL)
+		},
+	})
+}
+
+// releaseLease hands back a cron lease whose run never started
+func (s *Scheduler) releaseLease(ctx context.Context, name string) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := releaseLease(ctx, s.db, name, s.instanceID); err != nil {
+		s.logger.Warn("Job lease 반환 실패", "lease", name, "error", err)
+	}
+}
+
+// submit hands a task to the worker pool, blocking while all workers are busy
+func (s *Scheduler) submit(t task) {
+	select {
+	case s.tasks <- t:
+	case <-s.ctx.Done():
+		if t.release != nil {
+			t.release(context.WithoutCancel(s.ctx))
+		}
+	}
+}
+
+// worker executes tasks until the channel is closed
+func (s *Scheduler) worker() {
+	defer s.workers.Done()
+
+	for t := range s.tasks {
+		if s.stopping.Load() {
+			if t.release != nil {
+				t.release(context.WithoutCancel(s.runCtx))
+			}
+			continue
+		}
+		s.execute(t)
+	}
+}
+
+// execute runs a single task with panic recovery and logging
+func (s *Scheduler) execute(t task) {
+	log := s.logger.With("job", t.name)
+	ctx := logger.WithLogger(s.runCtx, log)
+	start := time.Now()
+
+	runCtx, cancelRun := context.WithCancel(ctx)
+	defer cancelRun()
+	stopRenew := func() {}
+	if t.renew != nil {
+		stopRenew = s.keepAlive(runCtx, cancelRun, t.renew, log)
+	}
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("job panic: %v", r)
+				log.Error("Job panic 발생", "panic", r, "stack", string(debug.Stack()))
+			}
+		}()
+		return t.run(runCtx)
+	}()
+	stopRenew()
+
+	if err != nil {
+		log.Error("Job 실행 실패", "elapsed", time.Since(start).String(), "error", err)
+	} else {
+		log.Info("Job 실행 완료", "elapsed", time.Since(start).String())
+	}
+
+	if t.finish != nil {
+		t.finish(context.WithoutCancel(ctx), err)
+	}
+}
+
+// keepAlive renews a running task's lease every LeaseTTL/3 until the returned function is called,
+// so a job running longer than LeaseTTL is not picked up by another replica.
+// When another replica took the lease over, the task is cancelled.
+func (s *Scheduler) keepAlive(ctx context.Context, cancel context.CancelFunc, renew func(ctx context.Context) (bool, error), log *slog.Logger) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(max(s.cfg.LeaseTTL/3, time.Millisecond))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			renewCtx, cancelRenew := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			held, err := renew(renewCtx)
+			cancelRenew()
+
+			switch {
+			case err != nil:
+				log.Warn("Job lease 연장 실패", "error", err)
+			case !held:
+				log.Error("Job lease를 다른 인스턴스가 가져가 실행을 취소합니다")
+				cancel()
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
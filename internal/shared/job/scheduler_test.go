@@ -0,0 +1,235 @@
+package job_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/job"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type reminderPayload struct {
+	PrayerID int `json:"prayerId"`
+}
+
+func setupScheduler(t *testing.T) (*gorm.DB, *job.Scheduler) {
+	t.Helper()
+
+	// Stop cancels in-flight claim transactions, which discards their connection:
+	// use a file database so the data survives, and a single connection since SQLite has one writer
+	db := testutil.SetupTestFileDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	return db, job.NewScheduler(db, testutil.NewTestConfig().Job)
+}
+
+func TestScheduler_RunsDueDelayedJob(t *testing.T) {
+	// Given: a handler and a job that is already due
+	db, scheduler := setupScheduler(t)
+
+	received := make(chan reminderPayload, 1)
+	scheduler.Handle("prayer.remind", func(ctx context.Context, payload []byte) error {
+		var p reminderPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return err
+		}
+		received <- p
+		return nil
+	})
+	require.NoError(t, job.Enqueue(context.Background(), db, "prayer.remind", reminderPayload{PrayerID: 42}, time.Now().Add(-time.Second)))
+
+	// When
+	scheduler.Start(context.Background())
+
+	// Then: handler receives the payload and the row is marked done after Stop
+	select {
+	case p := <-received:
+		assert.Equal(t, 42, p.PrayerID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("delayed job was not executed")
+	}
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, scheduler.Stop(stopCtx))
+
+	var row model.ScheduledJob
+	require.NoError(t, db.First(&row).Error)
+	assert.Equal(t, model.JobStatusDone, row.Status)
+	assert.Equal(t, 1, row.Attempts)
+}
+
+func TestScheduler_FutureJobIsNotRun(t *testing.T) {
+	db, scheduler := setupScheduler(t)
+
+	called := make(chan struct{}, 1)
+	scheduler.Handle("prayer.remind", func(ctx context.Context, payload []byte) error {
+		called <- struct{}{}
+		return nil
+	})
+	require.NoError(t, job.Enqueue(context.Background(), db, "prayer.remind", nil, time.Now().Add(time.Hour)))
+
+	scheduler.Start(context.Background())
+	time.Sleep(300 * time.Millisecond)
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, scheduler.Stop(stopCtx))
+
+	assert.Empty(t, called)
+
+	var row model.ScheduledJob
+	require.NoError(t, db.First(&row).Error)
+	assert.Equal(t, model.JobStatusPending, row.Status)
+}
+
+func TestScheduler_LongJobKeepsItsLock(t *testing.T) {
+	// Given: a job running several times longer than the lock
+	db := testutil.SetupTestFileDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	cfg := testutil.NewTestConfig().Job
+	cfg.LeaseTTL = 300 * time.Millisecond
+	scheduler := job.NewScheduler(db, cfg)
+
+	var runs atomic.Int32
+	scheduler.Handle("prayer.remind", func(ctx context.Context, payload []byte) error {
+		runs.Add(1)
+		time.Sleep(4 * cfg.LeaseTTL)
+		return nil
+	})
+	require.NoError(t, job.Enqueue(context.Background(), db, "prayer.remind", nil, time.Now().Add(-time.Second)))
+
+	// When: the other worker keeps polling while the job runs
+	scheduler.Start(context.Background())
+	time.Sleep(5 * cfg.LeaseTTL)
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, scheduler.Stop(stopCtx))
+
+	// Then: the lock was extended, so the job was not claimed again
+	assert.Equal(t, int32(1), runs.Load())
+
+	var row model.ScheduledJob
+	require.NoError(t, db.First(&row).Error)
+	assert.Equal(t, model.JobStatusDone, row.Status)
+	assert.Equal(t, 1, row.Attempts)
+}
+
+func TestScheduler_CronTickRunsOnceAcrossReplicas(t *testing.T) {
+	// Given: two replicas sharing one database and firing the same schedule
+	db, first := setupScheduler(t)
+	second := job.NewScheduler(db, testutil.NewTestConfig().Job)
+
+	var runs atomic.Int32
+	ran := make(chan struct{}, 2)
+	for _, scheduler := range []*job.Scheduler{first, second} {
+		require.NoError(t, scheduler.Schedule("token.purge", "@every 1s", func(ctx context.Context) error {
+			runs.Add(1)
+			ran <- struct{}{}
+			return nil
+		}))
+	}
+
+	// When: both fire the first tick
+	first.Start(context.Background())
+	second.Start(context.Background())
+
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Fatal("scheduled job was not executed")
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, first.Stop(stopCtx))
+	require.NoError(t, second.Stop(stopCtx))
+
+	// Then: the lease outlives the run, so the other replica skipped the tick
+	assert.Equal(t, int32(1), runs.Load())
+}
+
+func TestScheduler_StopWithBusyWorkersAndPendingTick(t *testing.T) {
+	// Given: the only worker runs a job that returns when cancelled, another job is queued
+	// and a cron tick is waiting for a free worker
+	db := testutil.SetupTestFileDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	cfg := testutil.NewTestConfig().Job
+	cfg.Workers = 1
+	scheduler := job.NewScheduler(db, cfg)
+
+	started := make(chan struct{}, 2)
+	scheduler.Handle("prayer.remind", func(ctx context.Context, payload []byte) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	for range 2 {
+		require.NoError(t, job.Enqueue(context.Background(), db, "prayer.remind", nil, time.Now().Add(-time.Second)))
+	}
+	var ticks atomic.Int32
+	require.NoError(t, scheduler.Schedule("token.purge", "@every 1s", func(ctx context.Context) error {
+		ticks.Add(1)
+		return nil
+	}))
+
+	scheduler.Start(context.Background())
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("delayed job was not executed")
+	}
+	time.Sleep(1500 * time.Millisecond)
+
+	// When
+	stopCtx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- scheduler.Stop(stopCtx)
+	}()
+
+	// Then: Stop gives up at its deadline and cancels the running job, the pending tick never runs
+	select {
+	case err := <-stopped:
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop did not return")
+	}
+	assert.Zero(t, ticks.Load())
+}
+
+func TestScheduler_InvalidCronSpec(t *testing.T) {
+	_, scheduler := setupScheduler(t)
+
+	err := scheduler.Schedule("broken", "not a cron", func(ctx context.Context) error { return nil })
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,237 @@
+// Package lifecycle starts and stops the server components in a fixed order.
+//
+// Components register a Hook in a Phase where they are created. Start runs the start hooks
+// phase by phase; Stop runs the stop hooks in reverse, each step with its own timeout and log line:
+//
+//	readiness  not-ready, pre-stop delay        (first to stop, last to start)
+//	server     drain HTTP
+//	worker     stop jobs, config watchers
+//	flush      stop the outbox dispatcher and deliver due events
+//	resource   close the database
+//	log        flush logs                       (last to stop, first to start)
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Phase orders hooks: started in ascending order, stopped in descending order.
+// Hooks of one phase start in registration order and stop in reverse registration order.
+type Phase int
+
+const (
+	PhaseLog       Phase = iota // logger, so the other steps' logs are flushed
+	PhaseResource               // database
+	PhaseFlush                  // outbox dispatcher
+	PhaseWorker                 // job scheduler, config watchers
+	PhaseServer                 // HTTP server
+	PhaseReadiness              // readiness probe and pre-stop delay
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseLog:
+		return "log"
+	case PhaseResource:
+		return "resource"
+	case PhaseFlush:
+		return "flush"
+	case PhaseWorker:
+		return "worker"
+	case PhaseServer:
+		return "server"
+	case PhaseReadiness:
+		return "readiness"
+	}
+	return fmt.Sprintf("phase(%d)", int(p))
+}
+
+// Hook is one step of a component's lifecycle. Both functions are optional;
+// a hook without OnStart is considered running from registration (e.g. an open database).
+type Hook struct {
+	Name    string
+	Phase   Phase
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+
+	// StopTimeout bounds OnStop (0: the manager default). OnStop should return once its ctx is done;
+	// otherwise it is abandoned when the timeout passes and left running while the next step starts.
+	StopTimeout time.Duration
+}
+
+type entry struct {
+	Hook
+	order   int
+	running bool
+}
+
+// Manager runs the registered hooks
+type Manager struct {
+	stopTimeout time.Duration
+
+	mu       sync.Mutex
+	entries  []*entry
+	stopOnce sync.Once
+	stopErr  error
+}
+
+// New creates a manager using stopTimeout for hooks without their own
+func New(stopTimeout time.Duration) *Manager {
+	return &Manager{stopTimeout: stopTimeout}
+}
+
+// Append registers a hook. Names must be unique.
+//
+// Usage:
+//
+//	lc.Append(lifecycle.Hook{Name: "database", Phase: lifecycle.PhaseResource, OnStop: func(context.Context) error { return db.Close() }})
+func (m *Manager) Append(hook Hook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, e := range m.entries {
+		if e.Name == hook.Name {
+			panic(fmt.Sprintf("lifecycle: 중복 hook 등록 name=%s", hook.Name))
+		}
+	}
+	m.entries = append(m.entries, &entry{Hook: hook, order: len(m.entries), running: hook.OnStart == nil})
+}
+
+// Start runs the start hooks in order. When one fails the hooks already running are stopped
+// and the error is returned.
+func (m *Manager) Start(ctx context.Context) error {
+	for _, e := range m.ordered(false) {
+		if e.running {
+			continue
+		}
+
+		start := time.Now()
+		if err := e.OnStart(ctx); err != nil {
+			slog.Error("시작 단계 실패", "step", e.Name, "phase", e.Phase.String(), "error", err)
+			_ = m.Stop(context.WithoutCancel(ctx))
+			return fmt.Errorf("%s 시작 실패: %w", e.Name, err)
+		}
+		e.running = true
+		slog.Debug("시작 단계 완료", "step", e.Name, "phase", e.Phase.String(), "elapsed", time.Since(start).String())
+	}
+	return nil
+}
+
+// Stop runs the stop hooks of the running components in reverse order, each with its own timeout.
+// A failed or timed out step is logged and the next one still runs. Cancel ctx to skip waiting
+// (e.g. on a second signal): the remaining steps still run, with a cancelled context.
+// Stop runs once; later calls return the first result.
+func (m *Manager) Stop(ctx context.Context) error {
+	m.stopOnce.Do(func() {
+		m.stopErr = m.stop(ctx)
+	})
+	return m.stopErr
+}
+
+func (m *Manager) stop(ctx context.Context) error {
+	var steps []*entry
+	for _, e := range m.ordered(true) {
+		if e.running && e.OnStop != nil {
+			steps = append(steps, e)
+		}
+	}
+
+	begin := time.Now()
+	slog.Info("종료 시작", "steps", len(steps))
+
+	var errs []error
+	for i, e := range steps {
+		if err := m.runStop(ctx, e, i+1, len(steps)); err != nil {
+			errs = append(errs, fmt.Errorf("%s 종료 실패: %w", e.Name, err))
+		}
+		e.running = false
+	}
+
+	slog.Info("종료 완료", "elapsed", time.Since(begin).String(), "failed", len(errs))
+	return errors.Join(errs...)
+}
+
+// runStop runs one stop hook with its timeout and logs the outcome
+func (m *Manager) runStop(ctx context.Context, e *entry, step, total int) error {
+	timeout := e.StopTimeout
+	if timeout <= 0 {
+		timeout = m.stopTimeout
+	}
+	stepCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	progress := fmt.Sprintf("%d/%d", step, total)
+	slog.Info("종료 단계 시작", "progress", progress, "step", e.Name, "phase", e.Phase.String(), "timeout", timeout.String())
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- callStop(stepCtx, e.OnStop)
+	}()
+
+	// A hook that ignores its ctx is abandoned (left running) so it cannot hold up the remaining steps
+	var err error
+	select {
+	case err = <-done:
+	case <-stepCtx.Done():
+		select {
+		case err = <-done:
+		default:
+			err = abandoned(ctx, stepCtx, timeout)
+		}
+	}
+	elapsed := time.Since(start).String()
+
+	switch {
+	case err != nil:
+		slog.Error("종료 단계 실패", "progress", progress, "step", e.Name, "elapsed", elapsed, "error", err)
+	case stepCtx.Err() != nil && ctx.Err() == nil:
+		// The hook returned without an error but only because its time ran out
+		slog.Warn("종료 단계 시간 초과", "progress", progress, "step", e.Name, "elapsed", elapsed)
+	default:
+		slog.Info("종료 단계 완료", "progress", progress, "step", e.Name, "elapsed", elapsed)
+	}
+	return err
+}
+
+// abandoned is the error of a step that did not return in time, or before ctx was cancelled
+func abandoned(ctx, stepCtx context.Context, timeout time.Duration) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("종료 대기 생략: %w", ctx.Err())
+	}
+	return fmt.Errorf("제한 시간 %s 초과: %w", timeout, stepCtx.Err())
+}
+
+// callStop runs fn, turning a panic into an error so the remaining steps still run
+func callStop(ctx context.Context, fn func(context.Context) error) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+	return fn(ctx)
+}
+
+// ordered returns the hooks in start order, or in stop order when reverse is true
+func (m *Manager) ordered(reverse bool) []*entry {
+	m.mu.Lock()
+	entries := slices.Clone(m.entries)
+	m.mu.Unlock()
+
+	slices.SortStableFunc(entries, func(a, b *entry) int {
+		if a.Phase != b.Phase {
+			return int(a.Phase) - int(b.Phase)
+		}
+		return a.order - b.order
+	})
+	if reverse {
+		slices.Reverse(entries)
+	}
+	return entries
+}
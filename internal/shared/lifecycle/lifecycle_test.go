@@ -0,0 +1,163 @@
+package lifecycle_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/lifecycle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder collects the order hooks ran in
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) hook(name string, phase lifecycle.Phase) lifecycle.Hook {
+	return lifecycle.Hook{
+		Name:    name,
+		Phase:   phase,
+		OnStart: func(context.Context) error { r.calls = append(r.calls, "start "+name); return nil },
+		OnStop:  func(context.Context) error { r.calls = append(r.calls, "stop "+name); return nil },
+	}
+}
+
+func TestManager_OrdersHooksByPhase(t *testing.T) {
+	// Given: hooks registered out of order
+	rec := &recorder{}
+	lc := lifecycle.New(time.Second)
+	lc.Append(rec.hook("http", lifecycle.PhaseServer))
+	lc.Append(rec.hook("jobs", lifecycle.PhaseWorker))
+	lc.Append(rec.hook("readiness", lifecycle.PhaseReadiness))
+	lc.Append(rec.hook("logger", lifecycle.PhaseLog))
+	lc.Append(rec.hook("outbox", lifecycle.PhaseFlush))
+	lc.Append(rec.hook("database", lifecycle.PhaseResource))
+	lc.Append(rec.hook("tracer", lifecycle.PhaseResource))
+
+	// When
+	require.NoError(t, lc.Start(context.Background()))
+	require.NoError(t, lc.Stop(context.Background()))
+
+	// Then
+	assert.Equal(t, []string{
+		"start logger", "start database", "start tracer", "start outbox", "start jobs", "start http", "start readiness",
+		"stop readiness", "stop http", "stop jobs", "stop outbox", "stop tracer", "stop database", "stop logger",
+	}, rec.calls)
+}
+
+func TestManager_StepTimeoutDoesNotBlockLaterSteps(t *testing.T) {
+	// Given: a stuck worker before the database
+	rec := &recorder{}
+	lc := lifecycle.New(time.Second)
+	lc.Append(rec.hook("database", lifecycle.PhaseResource))
+	lc.Append(lifecycle.Hook{
+		Name:        "stuck",
+		Phase:       lifecycle.PhaseWorker,
+		OnStop:      func(ctx context.Context) error { <-ctx.Done(); return ctx.Err() },
+		StopTimeout: 50 * time.Millisecond,
+	})
+	require.NoError(t, lc.Start(context.Background()))
+
+	// When
+	start := time.Now()
+	err := lc.Stop(context.Background())
+
+	// Then: bounded by the step timeout and the database still closes
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "stuck")
+	assert.Equal(t, []string{"start database", "stop database"}, rec.calls)
+}
+
+func TestManager_AbandonsStepIgnoringContext(t *testing.T) {
+	// Given: a hook that never looks at its ctx
+	rec := &recorder{}
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	lc := lifecycle.New(time.Second)
+	lc.Append(rec.hook("database", lifecycle.PhaseResource))
+	lc.Append(lifecycle.Hook{
+		Name:        "stuck",
+		Phase:       lifecycle.PhaseWorker,
+		OnStop:      func(context.Context) error { <-release; return nil },
+		StopTimeout: 50 * time.Millisecond,
+	})
+	require.NoError(t, lc.Start(context.Background()))
+
+	// When
+	start := time.Now()
+	err := lc.Stop(context.Background())
+
+	// Then: the step is abandoned after its timeout and the database still closes
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "stuck")
+	assert.Equal(t, []string{"start database", "stop database"}, rec.calls)
+}
+
+func TestManager_StartFailureStopsRunningHooks(t *testing.T) {
+	// Given
+	rec := &recorder{}
+	lc := lifecycle.New(time.Second)
+	lc.Append(rec.hook("database", lifecycle.PhaseResource))
+	lc.Append(lifecycle.Hook{
+		Name:    "http",
+		Phase:   lifecycle.PhaseServer,
+		OnStart: func(context.Context) error { return errors.New("address already in use") },
+		OnStop:  func(context.Context) error { rec.calls = append(rec.calls, "stop http"); return nil },
+	})
+	lc.Append(rec.hook("readiness", lifecycle.PhaseReadiness))
+
+	// When
+	err := lc.Start(context.Background())
+
+	// Then: hooks that never started are not stopped
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "address already in use")
+	assert.Equal(t, []string{"start database", "stop database"}, rec.calls)
+}
+
+func TestManager_StopRunsOnce(t *testing.T) {
+	// Given: a hook without OnStart is running from registration
+	stops := 0
+	lc := lifecycle.New(time.Second)
+	lc.Append(lifecycle.Hook{
+		Name:   "database",
+		Phase:  lifecycle.PhaseResource,
+		OnStop: func(context.Context) error { stops++; return nil },
+	})
+
+	// When: stopped without Start, e.g. when setup fails
+	require.NoError(t, lc.Stop(context.Background()))
+	require.NoError(t, lc.Stop(context.Background()))
+
+	// Then
+	assert.Equal(t, 1, stops)
+}
+
+func TestManager_RecoversPanickingStep(t *testing.T) {
+	rec := &recorder{}
+	lc := lifecycle.New(time.Second)
+	lc.Append(rec.hook("database", lifecycle.PhaseResource))
+	lc.Append(lifecycle.Hook{Name: "boom", Phase: lifecycle.PhaseWorker, OnStop: func(context.Context) error { panic("boom") }})
+	require.NoError(t, lc.Start(context.Background()))
+
+	err := lc.Stop(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+	assert.Contains(t, rec.calls, "stop database")
+}
+
+func TestManager_DuplicateNamePanics(t *testing.T) {
+	lc := lifecycle.New(time.Second)
+	lc.Append(lifecycle.Hook{Name: "database"})
+
+	assert.Panics(t, func() {
+		lc.Append(lifecycle.Hook{Name: "database"})
+	})
+}
@@ -1,34 +1,70 @@
 package logger
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"os"
+	"strings"
+	"syscall"
+)
+
+var (
+	// level is shared by the global handler so it can be changed at runtime (SetLevel)
+	level        = new(slog.LevelVar)
+	defaultLevel = slog.LevelInfo
 )
 
 // Setup configures the global slog logger based on environment
 func Setup(env string) {
 	var handler slog.Handler
 	opts := &slog.HandlerOptions{
-		Level: slog.LevelInfo,
+		Level: level,
 	}
 
 	switch env {
 	case "production", "prod":
 		// Production: JSON format, warn level
-		opts.Level = slog.LevelInfo
+		defaultLevel = slog.LevelInfo
 		handler = slog.NewJSONHandler(os.Stdout, opts)
 	case "local", "dev", "development":
 		// Development: Text format, debug level
-		opts.Level = slog.LevelDebug
+		defaultLevel = slog.LevelDebug
 		handler = slog.NewTextHandler(os.Stdout, opts)
 	default:
 		// Default: Info level
-		opts.Level = slog.LevelInfo
+		defaultLevel = slog.LevelInfo
 		handler = slog.NewTextHandler(os.Stdout, opts)
 	}
+	level.Set(defaultLevel)
 
 	logger := slog.New(handler)
 	slog.SetDefault(logger)
 
-	slog.Info("Logger 초기화", "env", env, "level", opts.Level.Level().String())
+	slog.Info("Logger 초기화", "env", env, "level", level.Level().String())
+}
+
+// SetLevel changes the global log level (debug|info|warn|error).
+// An empty name restores the environment default chosen by Setup.
+func SetLevel(name string) error {
+	if name == "" {
+		level.Set(defaultLevel)
+		return nil
+	}
+
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(strings.ToUpper(name))); err != nil {
+		return fmt.Errorf("유효하지 않은 로그 레벨: %s", name)
+	}
+	level.Set(l)
+	return nil
+}
+
+// Sync flushes log output redirected to a file. Outputs that cannot be synced (pipes, terminals) are skipped.
+func Sync() error {
+	err := os.Stdout.Sync()
+	if err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, syscall.ENOTTY) && !errors.Is(err, syscall.ENOTSUP) {
+		return fmt.Errorf("로그 출력 flush 실패: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/appversion"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Headers sent by the mobile apps
+const (
+	AppVersionHeader  = "X-App-Version"  // e.g. 1.4.0
+	AppPlatformHeader = "X-App-Platform" // ios | android
+)
+
+// forceUpdateDetails is rendered as "details" of ERROR-010 so the app can show the update prompt
+type forceUpdateDetails struct {
+	Platform       string `json:"platform"`
+	CurrentVersion string `json:"currentVersion"`
+	MinVersion     string `json:"minVersion"`
+	LatestVersion  string `json:"latestVersion,omitempty"`
+}
+
+// AppVersion rejects app clients older than the configured minimum version of their platform
+// with 426 ERROR-010 when CLIENT_ENFORCE_MIN_VERSION is true. The settings follow config reloads.
+//
+// Requests without X-App-Version/X-App-Platform (web, internal tools) and versions that cannot
+// be parsed pass through. Do not use it on the version endpoint outdated apps call to find out
+// about the update.
+func AppVersion(reloader *config.Reloader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client := reloader.Current().Client
+		if !client.EnforceMinVersion {
+			c.Next()
+			return
+		}
+
+		version := c.GetHeader(AppVersionHeader)
+		platform := strings.ToLower(c.GetHeader(AppPlatformHeader))
+		minVersion, latestVersion, ok := client.Versions(platform)
+		if version == "" || !ok || minVersion == "" {
+			c.Next()
+			return
+		}
+
+		current, err := appversion.Parse(version)
+		if err != nil {
+			logger.FromContext(c.Request.Context()).Debug("앱 버전 해석 실패 - 버전 확인 생략", "version", version)
+			c.Next()
+			return
+		}
+
+		// Configured versions are checked by Config.Validate
+		minimum, _ := appversion.Parse(minVersion)
+		if !current.Less(minimum) {
+			c.Next()
+			return
+		}
+
+		resp := sharedError.ForceUpdateRequired
+		resp.Details = forceUpdateDetails{
+			Platform:       platform,
+			CurrentVersion: version,
+			MinVersion:     minVersion,
+			LatestVersion:  latestVersion,
+		}
+		handler.WriteError(c, resp)
+		c.Abort()
+	}
+}
@@ -0,0 +1,107 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAppVersionRouter(client config.ClientConfig) *gin.Engine {
+	cfg := testutil.NewTestConfig()
+	cfg.Client = client
+
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.AppVersion(config.NewReloader(cfg, config.LoadOptions{})))
+	router.GET("/ping", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) })
+	return router
+}
+
+func TestAppVersion(t *testing.T) {
+	router := newAppVersionRouter(config.ClientConfig{
+		IOSMinVersion:        "1.4.0",
+		IOSLatestVersion:     "1.6.2",
+		AndroidMinVersion:    "2.0",
+		AndroidLatestVersion: "2.1.0",
+		EnforceMinVersion:    true,
+	})
+
+	tests := []struct {
+		name       string
+		platform   string
+		version    string
+		wantStatus int
+	}{
+		{"ios outdated", "ios", "1.3.9", http.StatusUpgradeRequired},
+		{"ios minimum", "ios", "1.4.0", http.StatusOK},
+		{"ios latest", "iOS", "1.6.2", http.StatusOK},
+		{"android outdated", "android", "1.99.0", http.StatusUpgradeRequired},
+		{"android newer", "android", "2.0.1", http.StatusOK},
+		{"no version header", "ios", "", http.StatusOK},
+		{"unknown platform", "web", "0.1.0", http.StatusOK},
+		{"unparsable version", "ios", "nightly", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+				Method: http.MethodGet,
+				URL:    "/ping",
+				Headers: map[string]string{
+					middleware.AppPlatformHeader: tt.platform,
+					middleware.AppVersionHeader:  tt.version,
+				},
+			})
+
+			// Then
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+		})
+	}
+}
+
+func TestAppVersion_ForceUpdateResponse(t *testing.T) {
+	// Given
+	router := newAppVersionRouter(config.ClientConfig{IOSMinVersion: "1.4.0", IOSLatestVersion: "1.6.2", EnforceMinVersion: true})
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+		Method:  http.MethodGet,
+		URL:     "/ping",
+		Headers: map[string]string{middleware.AppPlatformHeader: "ios", middleware.AppVersionHeader: "1.2.0"},
+	})
+
+	// Then
+	require.Equal(t, http.StatusUpgradeRequired, recorder.Code)
+
+	var body struct {
+		Code    string         `json:"code"`
+		Details map[string]any `json:"details"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, "ERROR-010", body.Code)
+	assert.Equal(t, map[string]any{
+		"platform":       "ios",
+		"currentVersion": "1.2.0",
+		"minVersion":     "1.4.0",
+		"latestVersion":  "1.6.2",
+	}, body.Details)
+}
+
+func TestAppVersion_NotEnforced(t *testing.T) {
+	router := newAppVersionRouter(config.ClientConfig{IOSMinVersion: "1.4.0"})
+
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+		Method:  http.MethodGet,
+		URL:     "/ping",
+		Headers: map[string]string{middleware.AppPlatformHeader: "ios", middleware.AppVersionHeader: "1.0.0"},
+	})
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
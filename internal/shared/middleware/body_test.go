@@ -0,0 +1,186 @@
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+func newBodyRouter(cfg config.BodyConfig) *gin.Engine {
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.RequestBody(cfg))
+
+	echo := handler.Handle(func(c *gin.Context) (any, error) {
+		var req echoRequest
+		if err := handler.ShouldBindJSON(c, &req); err != nil {
+			return nil, err
+		}
+		return req, nil
+	})
+	router.POST("/echo", echo)
+	router.POST("/upload", echo)
+	return router
+}
+
+func gzipData(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestRequestBody_Limits(t *testing.T) {
+	// Given: 32 bytes globally, 1 KB for /upload
+	router := newBodyRouter(config.BodyConfig{
+		MaxBytes:      32,
+		RouteMaxBytes: map[string]int64{"POST /upload": 1024},
+	})
+	large := map[string]string{"name": strings.Repeat("a", 100)}
+
+	tests := []struct {
+		name   string
+		url    string
+		status int
+	}{
+		{"over global limit", "/echo", http.StatusRequestEntityTooLarge},
+		{"route override", "/upload", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodPost, URL: tt.url, Body: large})
+
+			// Then
+			assert.Equal(t, tt.status, recorder.Code)
+			if tt.status == http.StatusRequestEntityTooLarge {
+				var resp sharedError.ErrorResponse
+				testutil.ParseResponse(t, recorder, &resp)
+				assert.Equal(t, sharedError.PayloadTooLarge.Code, resp.Code)
+			}
+		})
+	}
+}
+
+func TestRequestBody_ChunkedOverLimit(t *testing.T) {
+	// Given: no Content-Length, so the limit applies while reading
+	router := newBodyRouter(config.BodyConfig{MaxBytes: 32})
+	req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(`{"name":"`+strings.Repeat("a", 100)+`"}`))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+
+	// When
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	// Then
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+}
+
+func TestRequestBody_RequiresJSON(t *testing.T) {
+	// Given
+	router := newBodyRouter(config.BodyConfig{MaxBytes: 1024})
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+		Method:  http.MethodPost,
+		URL:     "/echo",
+		RawBody: []byte("name=a"),
+		Headers: map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+	var resp sharedError.ErrorResponse
+	testutil.ParseResponse(t, recorder, &resp)
+	assert.Equal(t, sharedError.UnsupportedMediaType.Code, resp.Code)
+}
+
+func TestRequestBody_Gzip(t *testing.T) {
+	router := newBodyRouter(config.BodyConfig{MaxBytes: 1024})
+
+	t.Run("decompressed", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodPost,
+			URL:     "/echo",
+			RawBody: gzipData(t, []byte(`{"name":"gzip"}`)),
+			Headers: map[string]string{"Content-Encoding": "gzip"},
+		})
+
+		// Then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"name":"gzip"}`, recorder.Body.String())
+	})
+
+	t.Run("bomb", func(t *testing.T) {
+		// Given: ~1 KB compressed, 1 MB decompressed
+		bomb := gzipData(t, []byte(`{"name":"`+strings.Repeat("a", 1<<20)+`"}`))
+		require.Less(t, len(bomb), 1024*4)
+
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodPost,
+			URL:     "/echo",
+			RawBody: bomb,
+			Headers: map[string]string{"Content-Encoding": "gzip"},
+		})
+
+		// Then
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+
+	t.Run("unsupported encoding", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodPost,
+			URL:     "/echo",
+			Body:    echoRequest{Name: "a"},
+			Headers: map[string]string{"Content-Encoding": "br"},
+		})
+
+		// Then
+		assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+	})
+}
+
+func TestRequestBody_StrictJSON(t *testing.T) {
+	// Given
+	binding.EnableDecoderDisallowUnknownFields = true
+	t.Cleanup(func() { binding.EnableDecoderDisallowUnknownFields = false })
+	router := newBodyRouter(config.BodyConfig{MaxBytes: 1024})
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+		Method: http.MethodPost,
+		URL:    "/echo",
+		Body:   map[string]string{"name": "a", "unknown": "b"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	var resp sharedError.ErrorResponse
+	testutil.ParseResponse(t, recorder, &resp)
+	assert.Equal(t, sharedError.InvalidRequest.Code, resp.Code)
+}
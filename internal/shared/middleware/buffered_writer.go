@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bufferedWriter holds back the whole response so middleware can decide what reaches the client
+// after the handler returned: the enforcing Timeout drops it on deadline, ETag may replace it by a 304.
+// flushTo copies the buffered response to the real writer.
+type bufferedWriter struct {
+	gin.ResponseWriter // real writer; only CloseNotify is passed through
+
+	header http.Header
+
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	status   int
+	size     int // -1 until the header is written, like gin's writer
+	timedOut bool
+}
+
+func newBufferedWriter(w gin.ResponseWriter) *bufferedWriter {
+	return &bufferedWriter{
+		ResponseWriter: w,
+		header:         w.Header().Clone(),
+		status:         w.Status(),
+		size:           -1,
+	}
+}
+
+func (bw *bufferedWriter) Header() http.Header {
+	return bw.header
+}
+
+func (bw *bufferedWriter) WriteHeader(code int) {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	if code > 0 && bw.size < 0 {
+		bw.status = code
+	}
+}
+
+func (bw *bufferedWriter) WriteHeaderNow() {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	if bw.size < 0 {
+		bw.size = 0
+	}
+}
+
+func (bw *bufferedWriter) Write(data []byte) (int, error) {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	if bw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if bw.size < 0 {
+		bw.size = 0
+	}
+	n, err := bw.buf.Write(data)
+	bw.size += n
+	return n, err
+}
+
+func (bw *bufferedWriter) WriteString(s string) (int, error) {
+	return bw.Write([]byte(s))
+}
+
+func (bw *bufferedWriter) Status() int {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	return bw.status
+}
+
+func (bw *bufferedWriter) Size() int {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	return bw.size
+}
+
+func (bw *bufferedWriter) Written() bool {
+	return bw.Size() != -1
+}
+
+// Flush is a no-op: the response is sent as a whole once the handler finishes
+func (bw *bufferedWriter) Flush() {}
+
+func (bw *bufferedWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, errors.New("버퍼링된 응답에서는 Hijack을 지원하지 않습니다")
+}
+
+func (bw *bufferedWriter) Pusher() http.Pusher {
+	return nil
+}
+
+// timeout marks the writer as timed out so later writes fail and flushTo is a no-op;
+// false if already timed out
+func (bw *bufferedWriter) timeout() bool {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	if bw.timedOut {
+		return false
+	}
+	bw.timedOut = true
+	return true
+}
+
+// flushTo copies the buffered response to w unless the writer timed out
+func (bw *bufferedWriter) flushTo(w gin.ResponseWriter) {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+
+	if bw.timedOut {
+		return
+	}
+
+	dst := w.Header()
+	for key := range dst {
+		if _, ok := bw.header[key]; !ok {
+			delete(dst, key)
+		}
+	}
+	for key, values := range bw.header {
+		dst[key] = values
+	}
+
+	w.WriteHeader(bw.status)
+	if bw.size >= 0 {
+		w.WriteHeaderNow()
+		_, _ = w.Write(bw.buf.Bytes())
+	}
+}
@@ -0,0 +1,202 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+var (
+	gzipWriters = sync.Pool{New: func() any {
+		return gzip.NewWriter(io.Discard)
+	}}
+	brotliWriters = sync.Pool{New: func() any {
+		return brotli.NewWriterLevel(io.Discard, brotli.DefaultCompression)
+	}}
+)
+
+// Compress compresses responses with brotli or gzip, negotiated from Accept-Encoding (br preferred).
+//
+// Only text-like content types (JSON, text/*, XML, JavaScript) of at least minSize bytes are
+// compressed; smaller bodies cost more to compress than they save.
+func Compress(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		cw := &compressWriter{ResponseWriter: original, encoding: encoding, minSize: minSize}
+		c.Writer = cw
+		defer func() {
+			cw.finish()
+			c.Writer = original
+		}()
+
+		c.Next()
+	}
+}
+
+// negotiateEncoding picks br or gzip from Accept-Encoding, "" when neither is acceptable
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := make(map[string]bool)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+		accepted[name] = q > 0
+	}
+
+	for _, encoding := range []string{encodingBrotli, encodingGzip} {
+		if ok, listed := accepted[encoding]; ok || (!listed && accepted["*"]) {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// compressible reports whether a response of contentType is worth compressing
+func compressible(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		mediaType == "application/json",
+		mediaType == "application/javascript",
+		mediaType == "application/xml",
+		strings.HasSuffix(mediaType, "+json"),
+		strings.HasSuffix(mediaType, "+xml"):
+		return true
+	}
+	return false
+}
+
+// compressWriter holds the first minSize bytes back, then either compresses the
+// whole body or passes it through unchanged
+type compressWriter struct {
+	gin.ResponseWriter
+
+	encoding string
+	minSize  int
+
+	buf         []byte
+	encoder     io.WriteCloser // set once compression started
+	passthrough bool           // decided not to compress
+	headerNow   bool           // WriteHeaderNow called while still undecided
+}
+
+func (cw *compressWriter) Write(data []byte) (int, error) {
+	switch {
+	case cw.passthrough:
+		return cw.ResponseWriter.Write(data)
+	case cw.encoder != nil:
+		return cw.encoder.Write(data)
+	}
+
+	cw.buf = append(cw.buf, data...)
+	if len(cw.buf) >= cw.minSize {
+		if err := cw.decide(); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+func (cw *compressWriter) WriteString(s string) (int, error) {
+	return cw.Write([]byte(s))
+}
+
+// WriteHeaderNow is delayed until the body decides whether Content-Encoding is set
+func (cw *compressWriter) WriteHeaderNow() {
+	if cw.passthrough || cw.encoder != nil {
+		cw.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	cw.headerNow = true
+}
+
+func (cw *compressWriter) Written() bool {
+	return cw.ResponseWriter.Written() || cw.headerNow || len(cw.buf) > 0
+}
+
+func (cw *compressWriter) Flush() {
+	if cw.encoder == nil && !cw.passthrough {
+		_ = cw.decide()
+	}
+	if flusher, ok := cw.encoder.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	cw.ResponseWriter.Flush()
+}
+
+// decide starts compression when the response is compressible and writes the buffered bytes
+func (cw *compressWriter) decide() error {
+	header := cw.Header()
+	status := cw.Status()
+
+	if len(cw.buf) < cw.minSize ||
+		header.Get("Content-Encoding") != "" ||
+		status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified ||
+		!compressible(header.Get("Content-Type")) {
+		cw.passthrough = true
+	} else {
+		header.Set("Content-Encoding", cw.encoding)
+		header.Add("Vary", "Accept-Encoding")
+		header.Del("Content-Length")
+		cw.encoder = cw.newEncoder()
+	}
+
+	buf := cw.buf
+	cw.buf = nil
+	_, err := cw.Write(buf)
+	return err
+}
+
+func (cw *compressWriter) newEncoder() io.WriteCloser {
+	if cw.encoding == encodingBrotli {
+		bw := brotliWriters.Get().(*brotli.Writer)
+		bw.Reset(cw.ResponseWriter)
+		return bw
+	}
+	gw := gzipWriters.Get().(*gzip.Writer)
+	gw.Reset(cw.ResponseWriter)
+	return gw
+}
+
+// finish writes what is still buffered and closes the encoder
+func (cw *compressWriter) finish() {
+	switch {
+	case cw.encoder != nil:
+		_ = cw.encoder.Close()
+		switch encoder := cw.encoder.(type) {
+		case *brotli.Writer:
+			brotliWriters.Put(encoder)
+		case *gzip.Writer:
+			gzipWriters.Put(encoder)
+		}
+	case !cw.passthrough && (len(cw.buf) > 0 || cw.headerNow):
+		cw.passthrough = true
+		cw.ResponseWriter.WriteHeaderNow()
+		_, _ = cw.ResponseWriter.Write(cw.buf)
+	}
+}
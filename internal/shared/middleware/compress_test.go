@@ -0,0 +1,128 @@
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCompressRouter() *gin.Engine {
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.Compress(64), middleware.ETag())
+	router.GET("/large", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"items": strings.Repeat("pray ", 100)})
+	})
+	router.GET("/small", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+	router.GET("/binary", func(c *gin.Context) {
+		c.Data(http.StatusOK, "image/png", bytes.Repeat([]byte{0x89}, 256))
+	})
+	return router
+}
+
+func TestCompress_Negotiation(t *testing.T) {
+	router := newCompressRouter()
+
+	tests := []struct {
+		name           string
+		url            string
+		acceptEncoding string
+		wantEncoding   string
+	}{
+		{"brotli preferred", "/large", "gzip, deflate, br", "br"},
+		{"gzip", "/large", "gzip", "gzip"},
+		{"brotli refused", "/large", "br;q=0, gzip", "gzip"},
+		{"not accepted", "/large", "", ""},
+		{"below threshold", "/small", "gzip", ""},
+		{"not compressible", "/binary", "gzip", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+				Method:  http.MethodGet,
+				URL:     tt.url,
+				Headers: map[string]string{"Accept-Encoding": tt.acceptEncoding},
+			})
+
+			// Then
+			require.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, tt.wantEncoding, recorder.Header().Get("Content-Encoding"))
+
+			var reader io.Reader = recorder.Body
+			switch tt.wantEncoding {
+			case "br":
+				reader = brotli.NewReader(recorder.Body)
+			case "gzip":
+				zr, err := gzip.NewReader(recorder.Body)
+				require.NoError(t, err)
+				reader = zr
+			}
+			body, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			if tt.url == "/large" {
+				assert.Contains(t, string(body), "pray pray")
+			}
+		})
+	}
+}
+
+func TestETag_ConditionalGet(t *testing.T) {
+	// Given
+	router := newCompressRouter()
+	first := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/small"})
+	etag := first.Header().Get("ETag")
+	require.True(t, strings.HasPrefix(etag, `W/"`), "weak ETag expected, got %q", etag)
+
+	t.Run("matching If-None-Match returns 304", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodGet,
+			URL:     "/small",
+			Headers: map[string]string{"If-None-Match": `"other", ` + etag},
+		})
+
+		// Then
+		assert.Equal(t, http.StatusNotModified, recorder.Code)
+		assert.Empty(t, recorder.Body.String())
+		assert.Equal(t, etag, recorder.Header().Get("ETag"))
+	})
+
+	t.Run("same tag for compressed response", func(t *testing.T) {
+		// When
+		plain := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/large"})
+		compressed := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodGet,
+			URL:     "/large",
+			Headers: map[string]string{"Accept-Encoding": "gzip"},
+		})
+
+		// Then
+		assert.Equal(t, plain.Header().Get("ETag"), compressed.Header().Get("ETag"))
+	})
+
+	t.Run("stale tag returns body", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodGet,
+			URL:     "/small",
+			Headers: map[string]string{"If-None-Match": `W/"stale"`},
+		})
+
+		// Then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"ok":true}`, recorder.Body.String())
+	})
+}
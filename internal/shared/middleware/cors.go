@@ -1,26 +1,64 @@
 package middleware
 
 import (
-	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"log/slog"
+	"sync/atomic"
 	"time"
 
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
-func CORS(cfg *config.Config) gin.HandlerFunc {
+// corsHandler is the cors handler built for one runtime config version
+type corsHandler struct {
+	version uint64
+	handler gin.HandlerFunc
+}
+
+// CORS applies the CORS settings of the current runtime config.
+// The underlying handler is rebuilt once per config version, not per request.
+func CORS(reloader *config.Reloader) gin.HandlerFunc {
+	var cached atomic.Pointer[corsHandler]
+
+	return func(c *gin.Context) {
+		rc := reloader.Current()
+
+		h := cached.Load()
+		if h == nil || h.version != rc.Version {
+			h = &corsHandler{version: rc.Version, handler: newCORSHandler(rc.CORS)}
+			cached.Store(h)
+		}
+
+		h.handler(c)
+	}
+}
+
+// newCORSHandler builds the cors handler for cfg.
+// Origins are checked with cfg.OriginMatcher; a disallowed origin gets 403. Only "*" without
+// credentials answers with Access-Control-Allow-Origin: *, otherwise the request origin is echoed.
+func newCORSHandler(cfg config.CORSConfig) gin.HandlerFunc {
 	corsConfig := cors.Config{
-		AllowOrigins:     cfg.CORS.AllowedOrigins,
-		AllowMethods:     cfg.CORS.AllowedMethods,
-		AllowHeaders:     cfg.CORS.AllowedHeaders,
-		AllowCredentials: cfg.CORS.AllowCredentials,
-		MaxAge:           time.Duration(cfg.CORS.MaxAge) * time.Second,
+		AllowMethods:     cfg.AllowedMethods,
+		AllowHeaders:     cfg.AllowedHeaders,
+		ExposeHeaders:    cfg.ExposedHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           time.Duration(cfg.MaxAge) * time.Second,
 	}
 
-	if len(corsConfig.AllowOrigins) == 1 && corsConfig.AllowOrigins[0] == "*" {
+	if cfg.AllowsAllOrigins() && !cfg.AllowCredentials {
 		corsConfig.AllowAllOrigins = true
-		corsConfig.AllowOrigins = nil
+		return cors.New(corsConfig)
+	}
+
+	match, err := cfg.OriginMatcher()
+	if err != nil {
+		// Validate rejects invalid patterns, so this only guards configs built in code
+		slog.Error("CORS Origin 설정 오류 - 모든 cross-origin 요청 거부", "error", err)
+		match = func(string) bool { return false }
 	}
+	corsConfig.AllowOriginFunc = match
 
 	return cors.New(corsConfig)
 }
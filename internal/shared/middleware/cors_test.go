@@ -0,0 +1,160 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCORSRouter(cors config.CORSConfig) *gin.Engine {
+	cfg := testutil.NewTestConfig()
+	cfg.CORS = cors
+
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.CORS(config.NewReloader(cfg, config.LoadOptions{})))
+	router.GET("/ping", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) })
+	router.POST("/ping", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) })
+	return router
+}
+
+func preflight(origin string) testutil.TestRequest {
+	return testutil.TestRequest{
+		Method: http.MethodOptions,
+		URL:    "/ping",
+		Headers: map[string]string{
+			"Origin":                         origin,
+			"Access-Control-Request-Method":  http.MethodPost,
+			"Access-Control-Request-Headers": "Content-Type, Authorization",
+		},
+	}
+}
+
+func TestCORS_Preflight(t *testing.T) {
+	router := newCORSRouter(config.CORSConfig{
+		AllowedOrigins: []string{
+			"https://app.example.com",
+			"https://*.preview.example.com",
+			"http://localhost:*",
+			`regex:https://pr-\d+\.review\.example\.com`,
+		},
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Content-Type", "Authorization"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	})
+
+	tests := []struct {
+		name    string
+		origin  string
+		allowed bool
+	}{
+		{"exact", "https://app.example.com", true},
+		{"exact is case-insensitive", "https://APP.example.com", true},
+		{"other scheme", "http://app.example.com", false},
+		{"subdomain wildcard", "https://feature-1.preview.example.com", true},
+		{"nested subdomain", "https://a.b.preview.example.com", true},
+		{"wildcard needs a subdomain", "https://preview.example.com", false},
+		{"suffix lookalike", "https://evilpreview.example.com", false},
+		{"port wildcard", "http://localhost:5173", true},
+		{"port wildcard needs a port", "http://localhost", false},
+		{"regex", "https://pr-42.review.example.com", true},
+		{"regex is anchored", "https://pr-42.review.example.com.evil.io", false},
+		{"unknown", "https://evil.io", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			recorder := testutil.ExecuteRequest(t, router, preflight(tt.origin))
+
+			// Then
+			if !tt.allowed {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+				return
+			}
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+			assert.Equal(t, tt.origin, recorder.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, "true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+			assert.Equal(t, "GET,POST", recorder.Header().Get("Access-Control-Allow-Methods"))
+			assert.Equal(t, "Content-Type,Authorization", recorder.Header().Get("Access-Control-Allow-Headers"))
+			assert.Equal(t, "600", recorder.Header().Get("Access-Control-Max-Age"))
+			assert.Contains(t, recorder.Header().Values("Vary"), "Origin")
+		})
+	}
+}
+
+func TestCORS_WildcardWithCredentialsEchoesOrigin(t *testing.T) {
+	// Given: browsers reject Access-Control-Allow-Origin: * on credentialed requests
+	router := newCORSRouter(config.CORSConfig{
+		AllowedOrigins:   []string{"*"},
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowCredentials: true,
+	})
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, preflight("https://any.example"))
+
+	// Then
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "https://any.example", recorder.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORS_WildcardWithoutCredentials(t *testing.T) {
+	// Given
+	router := newCORSRouter(config.CORSConfig{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET"},
+	})
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+		Method:  http.MethodGet,
+		URL:     "/ping",
+		Headers: map[string]string{"Origin": "https://any.example"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestCORS_ExposedHeaders(t *testing.T) {
+	// Given
+	router := newCORSRouter(testutil.NewTestConfig().CORS)
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+		Method:  http.MethodGet,
+		URL:     "/ping",
+		Headers: map[string]string{"Origin": "https://app.example.com"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-Request-Id", recorder.Header().Get("Access-Control-Expose-Headers"))
+}
+
+func TestCORS_NoAllowedOrigins(t *testing.T) {
+	// Given: strict preset without configured origins
+	router := newCORSRouter(config.CORSConfig{Preset: config.CORSPresetStrict, AllowedMethods: []string{"GET"}})
+
+	t.Run("cross-origin request is rejected", func(t *testing.T) {
+		recorder := testutil.ExecuteRequest(t, router, preflight("http://localhost:3000"))
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("request without Origin is not affected", func(t *testing.T) {
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/ping"})
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorHandler renders errors added with c.Error (e.g. by handler.Handle) when no response was written.
+//
+// Mapping:
+//   - registered domain error      -> its registered response
+//   - *handler.BindError           -> 400 (field errors for validation failures), 413 or 415
+//   - context.DeadlineExceeded     -> 504
+//   - context.Canceled             -> 499 (client closed request)
+//   - anything else                -> 500
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		resp, level := classifyError(c.Request.Context(), err)
+
+		logger.FromContext(c.Request.Context()).Log(c.Request.Context(), level, "요청 처리 오류",
+			"code", resp.Code,
+			"status", resp.Status,
+			"error", err.Error(),
+		)
+
+		handler.WriteError(c, resp)
+	}
+}
+
+// classifyError maps err to its response and the level it should be logged at
+func classifyError(ctx context.Context, err error) (sharedError.ErrorResponse, slog.Level) {
+	if resp, ok := sharedError.ResolveDomainError(err); ok {
+		if resp.Status >= 500 {
+			return resp, slog.LevelError
+		}
+		return resp, slog.LevelWarn
+	}
+
+	var bindErr *handler.BindError
+	if errors.As(err, &bindErr) {
+		return handler.BindErrorResponse(bindErr.Err), slog.LevelInfo
+	}
+
+	// Drivers do not always wrap context errors, so also check the request context itself
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return sharedError.RequestTimeout, slog.LevelWarn
+	case errors.Is(err, context.Canceled) || errors.Is(ctx.Err(), context.Canceled):
+		// The client is gone; the response is only for the access log
+		return sharedError.ClientClosedRequest, slog.LevelInfo
+	}
+
+	return sharedError.InternalServerError, slog.LevelError
+}
@@ -0,0 +1,74 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTestNotFound = sharedError.NewDomainError("TEST_NOT_FOUND")
+
+func init() {
+	sharedError.RegisterDomainErrorResponse("TEST_NOT_FOUND", sharedError.ErrorResponse{
+		Status:     http.StatusNotFound,
+		Code:       "TEST-404",
+		MessageKey: "error.invalid_request",
+	})
+}
+
+func TestErrorHandler_MapsErrorClasses(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"domain error", fmt.Errorf("조회 실패: %w", errTestNotFound), http.StatusNotFound, "TEST-404"},
+		{"deadline", fmt.Errorf("쿼리 실패: %w", context.DeadlineExceeded), http.StatusGatewayTimeout, sharedError.RequestTimeout.Code},
+		{"client cancel", context.Canceled, sharedError.StatusClientClosedRequest, sharedError.ClientClosedRequest.Code},
+		{"bind", &handler.BindError{Err: errors.New("invalid character")}, http.StatusBadRequest, sharedError.InvalidRequest.Code},
+		{"unknown", errors.New("boom"), http.StatusInternalServerError, sharedError.InternalServerError.Code},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := testutil.SetupTestRouter()
+			router.GET("/test", handler.Handle(func(c *gin.Context) (any, error) {
+				return nil, tt.err
+			}))
+
+			recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/test"})
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			var resp sharedError.ErrorResponse
+			testutil.ParseResponse(t, recorder, &resp)
+			assert.Equal(t, tt.wantCode, resp.Code)
+			assert.NotEmpty(t, resp.Message)
+		})
+	}
+}
+
+func TestHandle_SuccessResults(t *testing.T) {
+	router := testutil.SetupTestRouter()
+	router.GET("/ok", handler.Handle(func(c *gin.Context) (any, error) {
+		return gin.H{"name": "pray"}, nil
+	}))
+	router.POST("/created", handler.Handle(func(c *gin.Context) (any, error) {
+		return handler.Created(gin.H{}), nil
+	}))
+	router.DELETE("/gone", handler.Handle(func(c *gin.Context) (any, error) {
+		return handler.NoContent(), nil
+	}))
+
+	assert.Equal(t, http.StatusOK, testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/ok"}).Code)
+	assert.Equal(t, http.StatusCreated, testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodPost, URL: "/created"}).Code)
+	assert.Equal(t, http.StatusNoContent, testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodDelete, URL: "/gone"}).Code)
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag adds a weak ETag to successful GET/HEAD responses and answers 304 Not Modified
+// when it matches If-None-Match, so polling clients skip re-downloading unchanged bodies.
+//
+// The tag is a hash of the uncompressed body, so it is the same for every Content-Encoding.
+// Handlers that set their own ETag header are left alone.
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		bw := newBufferedWriter(original)
+		c.Writer = bw
+		defer func() { c.Writer = original }()
+
+		c.Next()
+
+		if bw.status == http.StatusOK && bw.size > 0 && bw.header.Get("ETag") == "" {
+			etag := weakETag(bw.buf.Bytes())
+			bw.header.Set("ETag", etag)
+
+			if etagMatches(c.GetHeader("If-None-Match"), etag) {
+				bw.status = http.StatusNotModified
+				bw.buf.Reset()
+				bw.header.Del("Content-Type")
+				bw.header.Del("Content-Length")
+			}
+		}
+
+		bw.flushTo(original)
+	}
+}
+
+// weakETag returns W/"<hash>" for body
+func weakETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches applies the weak comparison of If-None-Match (RFC 9110 13.1.2)
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/idempotency"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// anonymousScope is the scope of requests without an authenticated member
+const anonymousScope = "anonymous"
+
+// replayedHeaders are the response headers stored and replayed with the body
+var replayedHeaders = []string{"Content-Type", "Content-Language", "Location"}
+
+// Idempotency makes retries of a request with the same Idempotency-Key header safe.
+// Requests without the header are not affected.
+//
+//   - first request: reserves the key per member, runs the handler and stores status/body for cfg.TTL
+//   - retry with the same payload: the stored response is replayed (Idempotent-Replayed: true)
+//   - retry while the first is still running: 409
+//   - same key with a different method, path or body: 422
+//
+// Only responses the handler wrote with a status below 500 are stored. Errors rendered later by
+// ErrorHandler and 5xx responses release the key, so a retry runs the handler again.
+// Use it behind JWT so keys are scoped per member.
+func Idempotency(store idempotency.Store, cfg config.IdempotencyConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(idempotency.Header)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > idempotency.MaxKeyLength {
+			handler.WriteError(c, sharedError.InvalidRequest)
+			c.Abort()
+			return
+		}
+
+		body, err := readBody(c)
+		if err != nil {
+			handler.WriteError(c, handler.BindErrorResponse(err))
+			c.Abort()
+			return
+		}
+
+		ctx := c.Request.Context()
+		log := logger.FromContext(ctx)
+		rec := idempotency.Record{
+			Scope:       idempotencyScope(c),
+			Key:         key,
+			RequestHash: idempotency.HashRequest(c.Request.Method, c.Request.URL.RequestURI(), body),
+			ExpiresAt:   time.Now().Add(cfg.LockTTL),
+		}
+
+		existing, err := store.Reserve(ctx, rec)
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		if existing != nil {
+			switch {
+			case existing.RequestHash != rec.RequestHash:
+				log.Warn("Idempotency-Key 재사용 (다른 요청)", "scope", rec.Scope)
+				handler.WriteError(c, sharedError.IdempotencyKeyReused)
+			case existing.InFlight():
+				handler.WriteError(c, sharedError.IdempotencyInProgress)
+			default:
+				log.Info("Idempotency 응답 재전송", "scope", rec.Scope, "status", existing.Status)
+				replay(c, existing)
+			}
+			c.Abort()
+			return
+		}
+
+		rw := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = rw
+
+		// Store or release even when the request context is already done
+		storeCtx := context.WithoutCancel(ctx)
+		completed := false
+		defer func() {
+			c.Writer = rw.ResponseWriter
+			if completed {
+				return
+			}
+			if err := store.Release(storeCtx, rec.Scope, rec.Key); err != nil {
+				log.Error("Idempotency key 해제 실패", "error", err)
+			}
+		}()
+
+		c.Next()
+
+		if !rw.Written() || rw.Status() >= http.StatusInternalServerError {
+			return
+		}
+
+		rec.Status = rw.Status()
+		rec.Header = make(http.Header)
+		for _, name := range replayedHeaders {
+			if values := rw.Header().Values(name); len(values) > 0 {
+				rec.Header[name] = values
+			}
+		}
+		rec.Body = rw.body.Bytes()
+		rec.ExpiresAt = time.Now().Add(cfg.TTL)
+
+		if err := store.Complete(storeCtx, rec); err != nil {
+			log.Error("Idempotency 응답 저장 실패", "error", err)
+			return
+		}
+		completed = true
+	}
+}
+
+// readBody reads the request body for hashing and puts it back for the handler
+func readBody(c *gin.Context) ([]byte, error) {
+	if c.Request.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func idempotencyScope(c *gin.Context) string {
+	if memberID, ok := sharedContext.GetMemberID(c); ok {
+		return strconv.FormatUint(uint64(memberID), 10)
+	}
+	return anonymousScope
+}
+
+func replay(c *gin.Context, rec *idempotency.Record) {
+	header := c.Writer.Header()
+	for name, values := range rec.Header {
+		header[name] = values
+	}
+	header.Set(idempotency.ReplayedHeader, "true")
+
+	c.Writer.WriteHeader(rec.Status)
+	c.Writer.WriteHeaderNow()
+	_, _ = c.Writer.Write(rec.Body)
+}
+
+// recordingWriter passes the response through and keeps a copy of the body
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(data)
+	w.body.Write(data[:n])
+	return n, err
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
@@ -0,0 +1,134 @@
+package middleware_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/idempotency"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var idempotencyConfig = config.IdempotencyConfig{TTL: time.Hour, LockTTL: time.Minute}
+
+func idempotentRequest(key string, body any) testutil.TestRequest {
+	return testutil.TestRequest{
+		Method:  http.MethodPost,
+		URL:     "/posts",
+		Body:    body,
+		Headers: map[string]string{idempotency.Header: key},
+	}
+}
+
+func TestIdempotency_ReplaysStoredResponse(t *testing.T) {
+	// Given
+	var calls atomic.Int32
+	router := testutil.SetupTestRouter()
+	router.POST("/posts", middleware.Idempotency(idempotency.NewMemoryStore(), idempotencyConfig), func(c *gin.Context) {
+		n := calls.Add(1)
+		c.JSON(http.StatusCreated, gin.H{"id": n})
+	})
+
+	// When
+	first := testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"}))
+	retry := testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"}))
+	other := testutil.ExecuteRequest(t, router, idempotentRequest("key-2", gin.H{"title": "a"}))
+
+	// Then: the handler ran once per key and the retry got the same response
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.JSONEq(t, first.Body.String(), retry.Body.String())
+	assert.Equal(t, "true", retry.Header().Get(idempotency.ReplayedHeader))
+	assert.Equal(t, "application/json; charset=utf-8", retry.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id":2}`, other.Body.String())
+}
+
+func TestIdempotency_DifferentPayload(t *testing.T) {
+	// Given
+	router := testutil.SetupTestRouter()
+	router.POST("/posts", middleware.Idempotency(idempotency.NewMemoryStore(), idempotencyConfig), func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"ok": true})
+	})
+	testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"}))
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "b"}))
+
+	// Then
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	var resp sharedError.ErrorResponse
+	testutil.ParseResponse(t, recorder, &resp)
+	assert.Equal(t, sharedError.IdempotencyKeyReused.Code, resp.Code)
+}
+
+func TestIdempotency_DifferentQueryString(t *testing.T) {
+	// Given
+	router := testutil.SetupTestRouter()
+	router.POST("/posts", middleware.Idempotency(idempotency.NewMemoryStore(), idempotencyConfig), func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"ok": true})
+	})
+	testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"}))
+
+	// When: same key and body, different query string
+	request := idempotentRequest("key-1", gin.H{"title": "a"})
+	request.URL = "/posts?draft=true"
+	recorder := testutil.ExecuteRequest(t, router, request)
+
+	// Then
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func TestIdempotency_ConcurrentDuplicate(t *testing.T) {
+	// Given: the first request blocks inside the handler
+	started := make(chan struct{})
+	release := make(chan struct{})
+	router := testutil.SetupTestRouter()
+	router.POST("/posts", middleware.Idempotency(idempotency.NewMemoryStore(), idempotencyConfig), func(c *gin.Context) {
+		close(started)
+		<-release
+		c.JSON(http.StatusCreated, gin.H{"ok": true})
+	})
+
+	done := make(chan int)
+	go func() {
+		done <- testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"})).Code
+	}()
+	<-started
+
+	// When
+	duplicate := testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"}))
+	close(release)
+
+	// Then
+	assert.Equal(t, http.StatusConflict, duplicate.Code)
+	assert.Equal(t, http.StatusCreated, <-done)
+}
+
+func TestIdempotency_ReleasesKeyOnServerError(t *testing.T) {
+	// Given: fails once, then succeeds
+	var calls atomic.Int32
+	router := testutil.SetupTestRouter()
+	router.POST("/posts", middleware.Idempotency(idempotency.NewMemoryStore(), idempotencyConfig), func(c *gin.Context) {
+		if calls.Add(1) == 1 {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"ok": false})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"ok": true})
+	})
+
+	// When
+	first := testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"}))
+	retry := testutil.ExecuteRequest(t, router, idempotentRequest("key-1", gin.H{"title": "a"}))
+
+	// Then: the retry ran the handler again
+	require.Equal(t, http.StatusServiceUnavailable, first.Code)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// InternalTokenHeader carries INTERNAL_TOKEN on requests from operators and internal tooling
+const InternalTokenHeader = "X-Internal-Token"
+
+// IsInternalRequest reports whether the request carries the configured internal token.
+// An empty token disables internal access.
+func IsInternalRequest(c *gin.Context, token string) bool {
+	if token == "" {
+		return false
+	}
+	given := c.GetHeader(InternalTokenHeader)
+	return subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+// InternalOnly rejects requests without the internal token with 403 (ERROR-013).
+// With an empty token every request is rejected, so internal endpoints are off until INTERNAL_TOKEN is set.
+func InternalOnly(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsInternalRequest(c, token) {
+			logger.FromContext(c.Request.Context()).Warn("내부 전용 API 접근 거부",
+				"client_ip", c.ClientIP(),
+				"method", c.Request.Method,
+				"path", c.Request.URL.Path,
+			)
+			handler.WriteError(c, sharedError.Forbidden)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -6,9 +6,9 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
 	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
 	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/token"
 
 	"github.com/gin-gonic/gin"
@@ -38,90 +38,98 @@ var (
 // Register JWT error responses
 func init() {
 	sharedError.RegisterDomainErrorResponse(missingToken, sharedError.ErrorResponse{
-		Status:  http.StatusUnauthorized,
-		Code:    "AUTH-000",
-		Message: "로그인을 해주세요.",
+		Status:     http.StatusUnauthorized,
+		Code:       "AUTH-000",
+		MessageKey: "auth.login_required",
 	})
 
 	sharedError.RegisterDomainErrorResponse(invalidToken, sharedError.ErrorResponse{
-		Status:  http.StatusUnauthorized,
-		Code:    "AUTH-000",
-		Message: "로그인을 해주세요.",
+		Status:     http.StatusUnauthorized,
+		Code:       "AUTH-000",
+		MessageKey: "auth.login_required",
 	})
 
 	sharedError.RegisterDomainErrorResponse(expiredToken, sharedError.ErrorResponse{
-		Status:  http.StatusUnauthorized,
-		Code:    "AUTH-000",
-		Message: "로그인을 해주세요.",
+		Status:     http.StatusUnauthorized,
+		Code:       "AUTH-000",
+		MessageKey: "auth.login_required",
 	})
 
 	sharedError.RegisterDomainErrorResponse(invalidClaims, sharedError.ErrorResponse{
-		Status:  http.StatusUnauthorized,
-		Code:    "AUTH-000",
-		Message: "로그인을 해주세요.",
+		Status:     http.StatusUnauthorized,
+		Code:       "AUTH-000",
+		MessageKey: "auth.login_required",
 	})
 }
 
-func JWT(cfg *config.Config) gin.HandlerFunc {
-	tokenManager := token.NewJWTManager(cfg)
-
+// JWT authenticates the request with tokenManager, the same manager that issues the tokens
+// (so a rotated secret is seen by both).
+func JWT(tokenManager token.Manager) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// 요청 정보 (로깅용)
-		clientIP := c.ClientIP()
-		method := c.Request.Method
-		path := c.Request.URL.Path
-		userAgent := c.Request.UserAgent()
-
-		// Step 1: 토큰 추출
-		token, err := extractToken(c)
-		if err != nil {
-			// 에러 발생 지점에서 바로 로깅
-			slog.Warn("JWT 토큰 추출 실패",
-				"step", "extract_token",
-				"error", err.Error(),
-				"client_ip", clientIP,
-				"method", method,
-				"path", path,
-				"user_agent", userAgent,
-			)
-			handleJWTError(c, err)
-			return
+		if Authenticate(c, tokenManager) {
+			c.Next()
 		}
+	}
+}
 
-		// Step 2: 토큰 검증
-		claims, err := tokenManager.ValidateToken(token)
-		if err != nil {
-			// 에러 발생 지점에서 바로 로깅
-			slog.Warn("JWT 토큰 검증 실패",
-				"step", "validate_token",
-				"error", err.Error(),
-				"client_ip", clientIP,
-				"method", method,
-				"path", path,
-				"user_agent", userAgent,
-			)
-			handleJWTError(c, mapTokenError(err))
-			return
-		}
+// Authenticate validates the request's bearer token and stores the member in the context.
+// On failure it writes the 401 response, aborts and returns false.
+func Authenticate(c *gin.Context, tokenManager token.Manager) bool {
+	// 요청 정보 (로깅용)
+	clientIP := c.ClientIP()
+	method := c.Request.Method
+	path := c.Request.URL.Path
+	userAgent := c.Request.UserAgent()
+
+	// Step 1: 토큰 추출
+	token, err := extractToken(c)
+	if err != nil {
+		// 에러 발생 지점에서 바로 로깅
+		slog.Warn("JWT 토큰 추출 실패",
+			"step", "extract_token",
+			"error", err.Error(),
+			"client_ip", clientIP,
+			"method", method,
+			"path", path,
+			"user_agent", userAgent,
+		)
+		handleJWTError(c, err)
+		return false
+	}
 
-		// 인증 성공 - Context에 사용자 정보 저장
-		c.Set(sharedContext.MemberIDKey, claims.MemberID)
-		c.Set(sharedContext.MemberEmailKey, claims.Email)
-		c.Next()
+	// Step 2: 토큰 검증
+	claims, err := tokenManager.ValidateToken(token)
+	if err != nil {
+		// 에러 발생 지점에서 바로 로깅
+		slog.Warn("JWT 토큰 검증 실패",
+			"step", "validate_token",
+			"error", err.Error(),
+			"client_ip", clientIP,
+			"method", method,
+			"path", path,
+			"user_agent", userAgent,
+		)
+		handleJWTError(c, mapTokenError(err))
+		return false
 	}
+
+	// 인증 성공 - Context에 사용자 정보 저장
+	c.Set(sharedContext.MemberIDKey, claims.MemberID)
+	c.Set(sharedContext.MemberEmailKey, claims.Email)
+	return true
 }
 
 // handleJWTError handles JWT errors using the standardized error response format
 // Note: Logging is done at the point of error detection in JWT() function
 func handleJWTError(c *gin.Context, err error) {
 	if resp, ok := sharedError.ResolveDomainError(err); ok {
-		c.JSON(resp.Status, resp)
+		handler.WriteError(c, resp)
 	} else {
 		// 예상치 못한 에러 → Fallback 응답
-		c.JSON(http.StatusUnauthorized, sharedError.ErrorResponse{
-			Status:  http.StatusUnauthorized,
-			Code:    "AUTH-999",
-			Message: "인증에 실패했습니다.",
+		handler.WriteError(c, sharedError.ErrorResponse{
+			Status:     http.StatusUnauthorized,
+			Code:       "AUTH-999",
+			MessageKey: "auth.failed",
 		})
 	}
 	c.Abort()
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// PanicReport describes a panic recovered while serving a request
+type PanicReport struct {
+	Value     any // recovered value (string, error, runtime.Error, ...)
+	Stack     []byte
+	RequestID string
+	Method    string
+	Route     string // registered route pattern (e.g. /api/v1/members/:id), empty if unmatched
+	Path      string
+	Time      time.Time
+}
+
+// CrashReporter forwards recovered panics to an external service (e.g. Sentry).
+// ReportPanic runs on the request goroutine, so implementations should not block for long.
+type CrashReporter interface {
+	ReportPanic(ctx context.Context, report PanicReport)
+}
+
+// Recovery recovers any panic, logs it with its stack trace, request ID and route,
+// reports it to reporter (optional, may be nil) and answers with sharedError.InternalServerError.
+func Recovery(reporter CrashReporter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			// Panics re-raised by the enforcing Timeout keep the handler goroutine's stack
+			stack := debug.Stack()
+			if p, ok := recovered.(*panicWithStack); ok {
+				recovered, stack = p.value, p.stack
+			}
+
+			// net/http convention: abort the response silently
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			report := PanicReport{
+				Value:     recovered,
+				Stack:     stack,
+				RequestID: GetRequestID(c),
+				Method:    c.Request.Method,
+				Route:     c.FullPath(),
+				Path:      c.Request.URL.Path,
+				Time:      time.Now(),
+			}
+
+			if isBrokenPipe(recovered) {
+				// Client went away mid-response: nothing to write, not a server bug
+				slog.Warn("Client connection closed",
+					"error", fmt.Sprint(recovered),
+					"request_id", report.RequestID,
+					"route", report.Route,
+				)
+				c.Abort()
+				return
+			}
+
+			slog.Error("Panic Recovered",
+				"panic", fmt.Sprint(recovered),
+				"panic_type", fmt.Sprintf("%T", recovered),
+				"request_id", report.RequestID,
+				"method", report.Method,
+				"route", report.Route,
+				"path", report.Path,
+				"stack", string(report.Stack),
+			)
+
+			if reporter != nil {
+				reportPanic(c.Request.Context(), reporter, report)
+			}
+
+			_ = c.Error(fmt.Errorf("panic: %v", recovered))
+			if c.Writer.Written() {
+				// Headers already sent: the response cannot be replaced
+				c.Abort()
+				return
+			}
+			handler.WriteError(c, sharedError.InternalServerError)
+			c.Abort()
+		}()
+
+		c.Next()
+	}
+}
+
+// reportPanic calls the reporter, never letting a reporter failure escape
+func reportPanic(ctx context.Context, reporter CrashReporter, report PanicReport) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Crash reporter 실패", "panic", fmt.Sprint(r), "request_id", report.RequestID)
+		}
+	}()
+
+	reporter.ReportPanic(ctx, report)
+}
+
+// isBrokenPipe reports whether the panic comes from writing to a closed client connection
+func isBrokenPipe(recovered any) bool {
+	err, ok := recovered.(error)
+	if !ok {
+		return false
+	}
+
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	var syscallErr *os.SyscallError
+	if !errors.As(opErr, &syscallErr) {
+		return false
+	}
+
+	msg := strings.ToLower(syscallErr.Error())
+	return strings.Contains(msg, "broken pipe") || strings.Contains(msg, "connection reset by peer")
+}
@@ -0,0 +1,80 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingReporter struct {
+	reports []middleware.PanicReport
+}
+
+func (r *recordingReporter) ReportPanic(_ context.Context, report middleware.PanicReport) {
+	r.reports = append(r.reports, report)
+}
+
+func TestRecovery_AnyPanicValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		panic func()
+	}{
+		{"string", func() { panic("boom") }},
+		{"error", func() { panic(errors.New("boom")) }},
+		{"runtime error", func() {
+			var m map[string]int
+			m["x"] = 1
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			reporter := &recordingReporter{}
+			router := testutil.SetupTestRouter()
+			router.Use(middleware.RequestID(), middleware.Recovery(reporter))
+			router.GET("/panic/:id", func(c *gin.Context) { tt.panic() })
+
+			// When
+			recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+				Method:  http.MethodGet,
+				URL:     "/panic/1",
+				Headers: map[string]string{middleware.RequestIDHeader: "req-1"},
+			})
+
+			// Then: standard error shape
+			assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+			var resp sharedError.ErrorResponse
+			testutil.ParseResponse(t, recorder, &resp)
+			assert.Equal(t, sharedError.InternalServerError.Code, resp.Code)
+			assert.NotEmpty(t, resp.Message)
+
+			// Then: reporter got the context
+			require.Len(t, reporter.reports, 1)
+			report := reporter.reports[0]
+			assert.NotNil(t, report.Value)
+			assert.NotEmpty(t, report.Stack)
+			assert.Equal(t, "req-1", report.RequestID)
+			assert.Equal(t, "/panic/:id", report.Route)
+			assert.Equal(t, "/panic/1", report.Path)
+		})
+	}
+}
+
+func TestRecovery_WithoutReporter(t *testing.T) {
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.Recovery(nil))
+	router.GET("/panic", func(c *gin.Context) { panic("boom") })
+
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/panic"})
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
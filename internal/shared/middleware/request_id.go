@@ -1,13 +1,14 @@
 package middleware
 
 import (
+	sharedContext "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/context"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
 const (
 	RequestIDHeader = "X-Request-ID"
-	RequestIDKey    = "request_id"
+	RequestIDKey    = sharedContext.RequestIDKey
 )
 
 func RequestID() gin.HandlerFunc {
@@ -26,10 +27,5 @@ func RequestID() gin.HandlerFunc {
 }
 
 func GetRequestID(c *gin.Context) string {
-	if requestID, exists := c.Get(RequestIDKey); exists {
-		if id, ok := requestID.(string); ok {
-			return id
-		}
-	}
-	return ""
+	return sharedContext.GetRequestID(c)
 }
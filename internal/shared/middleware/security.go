@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// SecurityHeaders sets the browser security headers configured in cfg (SECURITY_*):
+//
+//   - Strict-Transport-Security on HTTPS requests (browsers ignore it over HTTP); X-Forwarded-Proto
+//     is only believed from trustedProxies (TRUSTED_PROXIES, IP or CIDR)
+//   - X-Content-Type-Options, X-Frame-Options, Referrer-Policy on every response
+//   - Content-Security-Policy on HTML responses
+//
+// Headers are set before the handler runs, so error and panic responses carry them too.
+func SecurityHeaders(cfg config.SecurityConfig, trustedProxies []string) gin.HandlerFunc {
+	hsts := hstsValue(cfg)
+	proxies := parsePrefixes(trustedProxies)
+
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		if hsts != "" && isHTTPS(c, proxies) {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+		if cfg.ContentTypeOptions != "" {
+			header.Set("X-Content-Type-Options", cfg.ContentTypeOptions)
+		}
+		if cfg.FrameOptions != "" {
+			header.Set("X-Frame-Options", cfg.FrameOptions)
+		}
+		if cfg.ReferrerPolicy != "" {
+			header.Set("Referrer-Policy", cfg.ReferrerPolicy)
+		}
+
+		if cfg.ContentSecurityPolicy == "" {
+			c.Next()
+			return
+		}
+
+		// The content type is only known when the handler writes, so CSP is added then
+		original := c.Writer
+		c.Writer = &cspWriter{ResponseWriter: original, policy: cfg.ContentSecurityPolicy}
+		defer func() { c.Writer = original }()
+
+		c.Next()
+	}
+}
+
+func hstsValue(cfg config.SecurityConfig) string {
+	if cfg.HSTSMaxAge <= 0 {
+		return ""
+	}
+
+	value := "max-age=" + strconv.FormatInt(int64(cfg.HSTSMaxAge.Seconds()), 10)
+	if cfg.HSTSIncludeSubdomains {
+		value += "; includeSubDomains"
+	}
+	if cfg.HSTSPreload {
+		value += "; preload"
+	}
+	return value
+}
+
+// isHTTPS reports whether the client connected over HTTPS, directly or through a trusted TLS terminating proxy
+func isHTTPS(c *gin.Context, proxies []netip.Prefix) bool {
+	if c.Request.TLS != nil {
+		return true
+	}
+	if !strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https") {
+		return false
+	}
+
+	remote, err := netip.ParseAddr(c.RemoteIP())
+	if err != nil {
+		return false
+	}
+	remote = remote.Unmap()
+	for _, prefix := range proxies {
+		if prefix.Contains(remote) {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePrefixes parses IPs and CIDRs, skipping invalid entries (config validation rejects them)
+func parsePrefixes(values []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		if prefix, err := netip.ParsePrefix(value); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+		} else if addr, err := netip.ParseAddr(value); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+		}
+	}
+	return prefixes
+}
+
+// cspWriter adds Content-Security-Policy right before the header of an HTML response is written
+type cspWriter struct {
+	gin.ResponseWriter
+	policy string
+	done   bool
+}
+
+func (w *cspWriter) apply() {
+	if w.done {
+		return
+	}
+	w.done = true
+
+	header := w.Header()
+	if strings.HasPrefix(header.Get("Content-Type"), "text/html") && header.Get("Content-Security-Policy") == "" {
+		header.Set("Content-Security-Policy", w.policy)
+	}
+}
+
+func (w *cspWriter) WriteHeaderNow() {
+	w.apply()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *cspWriter) Write(data []byte) (int, error) {
+	w.apply()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *cspWriter) WriteString(s string) (int, error) {
+	w.apply()
+	return w.ResponseWriter.WriteString(s)
+}
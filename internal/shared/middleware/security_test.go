@@ -0,0 +1,81 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSecurityRouter trusts trustedProxies; test requests come from 192.0.2.1
+func newSecurityRouter(trustedProxies ...string) *gin.Engine {
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.SecurityHeaders(config.SecurityConfig{
+		HSTSMaxAge:            365 * 24 * time.Hour,
+		HSTSIncludeSubdomains: true,
+		ContentTypeOptions:    "nosniff",
+		FrameOptions:          "DENY",
+		ReferrerPolicy:        "no-referrer",
+		ContentSecurityPolicy: "default-src 'self'",
+	}, trustedProxies))
+	router.GET("/json", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) })
+	router.GET("/html", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte("<html></html>"))
+	})
+	return router
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	router := newSecurityRouter("192.0.2.0/24")
+
+	t.Run("JSON over HTTP", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/json"})
+
+		// Then
+		assert.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, "DENY", recorder.Header().Get("X-Frame-Options"))
+		assert.Equal(t, "no-referrer", recorder.Header().Get("Referrer-Policy"))
+		assert.Empty(t, recorder.Header().Get("Strict-Transport-Security"), "HSTS only over HTTPS")
+		assert.Empty(t, recorder.Header().Get("Content-Security-Policy"), "CSP only for HTML")
+	})
+
+	t.Run("HTML over HTTPS proxy", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{
+			Method:  http.MethodGet,
+			URL:     "/html",
+			Headers: map[string]string{"X-Forwarded-Proto": "https"},
+		})
+
+		// Then
+		assert.Equal(t, "max-age=31536000; includeSubDomains", recorder.Header().Get("Strict-Transport-Security"))
+		assert.Equal(t, "default-src 'self'", recorder.Header().Get("Content-Security-Policy"))
+	})
+
+	t.Run("X-Forwarded-Proto from untrusted client", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, newSecurityRouter("10.0.0.0/8"), testutil.TestRequest{
+			Method:  http.MethodGet,
+			URL:     "/json",
+			Headers: map[string]string{"X-Forwarded-Proto": "https"},
+		})
+
+		// Then
+		assert.Empty(t, recorder.Header().Get("Strict-Transport-Security"))
+	})
+
+	t.Run("error responses", func(t *testing.T) {
+		// When
+		recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/missing"})
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+	})
+}
@@ -2,19 +2,36 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net/http"
+	"runtime/debug"
 	"time"
 
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/handler"
 	"github.com/gin-gonic/gin"
 )
 
-const DefaultTimeout = 30 * time.Second
+// writeDeadlineGrace is added to the request deadline for the connection write deadline,
+// leaving time to send the 504 after the handler's deadline passed
+const writeDeadlineGrace = 5 * time.Second
 
 // Timeout middleware sets a timeout context for request processing
 // This is the Best Practice implementation using context propagation
 // Handlers must check context and handle timeout appropriately
-func Timeout(timeout time.Duration) gin.HandlerFunc {
+//
+// The timeout is read from the current runtime config on each request: SERVER_ROUTE_TIMEOUTS
+// for the matched route, otherwise SERVER_REQUEST_TIMEOUT.
+//
+// With SERVER_ENFORCE_TIMEOUT the handler runs with a buffered response writer and the client
+// gets a 504 as soon as the deadline passes, even if the handler ignores its context.
+func Timeout(reloader *config.Reloader) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		rc := reloader.Current()
+		timeout := rc.TimeoutFor(c.Request.Method, c.FullPath())
+
 		// Create a context with timeout
 		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
 		defer cancel()
@@ -27,22 +44,21 @@ func Timeout(timeout time.Duration) gin.HandlerFunc {
 		c.Set("request_deadline", deadline)
 		c.Set("request_timeout", timeout)
 
+		if rc.EnforceTimeout {
+			// Routes may be allowed more time than http.Server.WriteTimeout (not supported by every writer)
+			_ = http.NewResponseController(c.Writer).SetWriteDeadline(deadline.Add(writeDeadlineGrace))
+
+			runWithDeadline(c, ctx, timeout)
+			return
+		}
+
 		// Execute the handler chain
 		// No goroutine needed - handlers will respect context timeout
 		c.Next()
 
 		// After handler completes, check if timeout occurred
 		if ctx.Err() == context.DeadlineExceeded {
-			// Log the timeout occurrence
-			requestID, _ := c.Get(RequestIDKey)
-
-			slog.Warn("Request deadline exceeded",
-				"request_id", requestID,
-				"path", c.Request.URL.Path,
-				"method", c.Request.Method,
-				"timeout", timeout.String(),
-				"status", c.Writer.Status(),
-			)
+			logDeadlineExceeded(c, timeout, c.Writer.Status())
 
 			// Note: We don't send a response here because:
 			// 1. The handler might have already sent a response
@@ -52,6 +68,73 @@ func Timeout(timeout time.Duration) gin.HandlerFunc {
 	}
 }
 
+// panicWithStack carries a panic from the handler goroutine with its original stack trace
+type panicWithStack struct {
+	value any
+	stack []byte
+}
+
+// runWithDeadline runs the rest of the chain on a buffered writer and answers 504 when ctx expires first.
+//
+// After a timeout it still waits for the handler to return before giving the gin.Context back,
+// because gin reuses contexts from a pool. Late writes go to the discarded buffer.
+func runWithDeadline(c *gin.Context, ctx context.Context, timeout time.Duration) {
+	original := c.Writer
+	req := c.Request
+	requestID := GetRequestID(c)
+
+	bw := newBufferedWriter(original)
+	c.Writer = bw
+
+	// nil when the chain returned normally, *panicWithStack when it panicked
+	finished := make(chan any, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				finished <- &panicWithStack{value: p, stack: debug.Stack()}
+				return
+			}
+			finished <- nil
+		}()
+		c.Next()
+	}()
+
+	var result any
+	select {
+	case result = <-finished:
+	case <-ctx.Done():
+		select {
+		case result = <-finished:
+			// Finished at the same moment: keep the handler's response
+		default:
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && bw.timeout() {
+				logDeadlineExceeded(c, timeout, http.StatusGatewayTimeout)
+				handler.RenderError(original, req, requestID, sharedError.RequestTimeout)
+				original.Flush()
+			}
+			result = <-finished
+		}
+	}
+
+	c.Writer = original
+	if p, ok := result.(*panicWithStack); ok {
+		// Re-panic on the request goroutine so Recovery handles it
+		panic(p)
+	}
+	bw.flushTo(original)
+}
+
+func logDeadlineExceeded(c *gin.Context, timeout time.Duration, status int) {
+	slog.Warn("Request deadline exceeded",
+		"request_id", GetRequestID(c),
+		"path", c.Request.URL.Path,
+		"route", c.FullPath(),
+		"method", c.Request.Method,
+		"timeout", timeout.String(),
+		"status", status,
+	)
+}
+
 // TimeoutError is a helper function handlers can use to check for timeout
 func IsTimeout(c *gin.Context) bool {
 	ctx := c.Request.Context()
@@ -0,0 +1,102 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTimeoutRouter(timeout time.Duration, routeTimeouts map[string]time.Duration) *gin.Engine {
+	cfg := testutil.NewTestConfig()
+	cfg.Server.RequestTimeout = timeout
+	cfg.Server.RouteTimeouts = routeTimeouts
+
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.Timeout(config.NewReloader(cfg, config.LoadOptions{})))
+	return router
+}
+
+// slowHandler ignores its context and writes after d
+func slowHandler(d time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		time.Sleep(d)
+		c.JSON(http.StatusOK, gin.H{"status": "late"})
+	}
+}
+
+func TestTimeout_SlowHandlerGets504(t *testing.T) {
+	// Given
+	router := newTimeoutRouter(20*time.Millisecond, nil)
+	router.GET("/slow", slowHandler(100*time.Millisecond))
+
+	// When
+	start := time.Now()
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/slow"})
+
+	// Then: standard timeout response, late write discarded
+	assert.Equal(t, http.StatusGatewayTimeout, recorder.Code)
+	var resp sharedError.ErrorResponse
+	testutil.ParseResponse(t, recorder, &resp)
+	assert.Equal(t, sharedError.RequestTimeout.Code, resp.Code)
+	assert.NotContains(t, recorder.Body.String(), "late")
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond, "handler goroutine must finish before the context is released")
+}
+
+func TestTimeout_FastHandlerPassesThrough(t *testing.T) {
+	// Given
+	router := newTimeoutRouter(time.Second, nil)
+	router.GET("/fast", func(c *gin.Context) {
+		c.Header("X-Custom", "1")
+		c.JSON(http.StatusCreated, gin.H{"status": "ok"})
+	})
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/fast"})
+
+	// Then
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Equal(t, "1", recorder.Header().Get("X-Custom"))
+	assert.JSONEq(t, `{"status":"ok"}`, recorder.Body.String())
+}
+
+func TestTimeout_RouteOverride(t *testing.T) {
+	// Given: /export gets more time than the global timeout
+	router := newTimeoutRouter(20*time.Millisecond, map[string]time.Duration{
+		"GET /export/:id": time.Second,
+	})
+	router.GET("/export/:id", slowHandler(50*time.Millisecond))
+	router.GET("/other", slowHandler(50*time.Millisecond))
+
+	// When
+	exported := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/export/1"})
+	other := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/other"})
+
+	// Then
+	assert.Equal(t, http.StatusOK, exported.Code)
+	assert.Equal(t, http.StatusGatewayTimeout, other.Code)
+}
+
+func TestTimeout_PanicReachesRecovery(t *testing.T) {
+	// Given
+	reporter := &recordingReporter{}
+	router := testutil.SetupTestRouter()
+	router.Use(middleware.Recovery(reporter), middleware.Timeout(config.NewReloader(testutil.NewTestConfig(), config.LoadOptions{})))
+	router.GET("/panic", func(c *gin.Context) { panic("boom") })
+
+	// When
+	recorder := testutil.ExecuteRequest(t, router, testutil.TestRequest{Method: http.MethodGet, URL: "/panic"})
+
+	// Then: original value is reported
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	if assert.Len(t, reporter.reports, 1) {
+		assert.Equal(t, "boom", reporter.reports[0].Value)
+		assert.Contains(t, string(reporter.reports[0].Stack), "timeout_test.go")
+	}
+}
@@ -0,0 +1,257 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"gorm.io/gorm"
+)
+
+// Dispatcher polls pending outbox events and delivers them to registered handlers.
+// Rows are claimed with FOR UPDATE SKIP LOCKED on Oracle so multiple replicas can poll concurrently,
+// and delivered after the claim is committed.
+type Dispatcher struct {
+	db       *gorm.DB
+	registry *Registry
+	cfg      config.OutboxConfig
+	logger   *slog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewDispatcher creates a new outbox dispatcher
+func NewDispatcher(db *gorm.DB, registry *Registry, cfg config.OutboxConfig) *Dispatcher {
+	return &Dispatcher{
+		db:       db,
+		registry: registry,
+		cfg:      cfg,
+		logger:   slog.With("component", "outbox"),
+	}
+}
+
+// Start begins polling in a background goroutine
+func (d *Dispatcher) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.done = make(chan struct{})
+
+	go d.run(ctx)
+
+	d.logger.Info("Outbox dispatcher 시작",
+		"poll_interval", d.cfg.PollInterval.String(),
+		"batch_size", d.cfg.BatchSize,
+		"max_attempts", d.cfg.MaxAttempts,
+	)
+}
+
+// Stop stops polling and waits for the in-flight batch to finish or ctx to expire
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+
+	var err error
+	d.once.Do(func() {
+		d.cancel()
+		select {
+		case <-d.done:
+			d.logger.Info("Outbox dispatcher 종료 완료")
+		case <-ctx.Done():
+			err = fmt.Errorf("outbox dispatcher 종료 대기 시간 초과: %w", ctx.Err())
+		}
+	})
+	return err
+}
+
+// Flush delivers the events already due until none are left or ctx expires, and returns how many
+// were claimed. Call it after Stop during shutdown so events written by the last requests and jobs
+// are not left for the next deploy. Failed events keep their retry schedule.
+func (d *Dispatcher) Flush(ctx context.Context) (int, error) {
+	var total int
+	for {
+		n, err := d.DispatchOnce(ctx)
+		total += n
+		if err != nil || n < d.cfg.BatchSize {
+			return total, err
+		}
+	}
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	defer close(d.done)
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Drain consecutive full batches before waiting for the next tick
+		for {
+			n, err := d.DispatchOnce(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				d.logger.Error("Outbox 이벤트 처리 실패", "error", err)
+			}
+			if err != nil || n < d.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchOnce claims up to BatchSize due events, delivers them and records the outcome.
+// Returns the number of events claimed.
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	// Claim in a short transaction and deliver after commit, so handlers never run while rows are locked.
+	// A crash leaves the events PROCESSING until ClaimTimeout passes, then they are delivered again.
+	var events []model.OutboxEvent
+	err := database.WithTransaction(ctx, d.db, func(tx *gorm.DB) error {
+		var err error
+		events, err = d.claim(tx)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for i := range events {
+		d.deliver(ctx, &events[i])
+		if err := d.record(ctx, &events[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return len(events), errors.Join(errs...)
+}
+
+// claim locks due events (pending, or processing with an expired claim) and marks them PROCESSING
+func (d *Dispatcher) claim(tx *gorm.DB) ([]model.OutboxEvent, error) {
+	now := time.Now().UTC()
+	query := tx.
+		Model(&model.OutboxEvent{}).
+		Where("status IN ? AND next_attempt_at <= ?",
+			[]string{model.OutboxStatusPending, model.OutboxStatusProcessing}, now).
+		Order("id")
+
+	events, err := database.Fetch[model.OutboxEvent](tx, query, d.cfg.BatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("outbox 이벤트 조회 실패: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint64, len(events))
+	claimUntil := now.Add(d.cfg.ClaimTimeout)
+	for i := range events {
+		ids[i] = events[i].ID
+		events[i].Status = model.OutboxStatusProcessing
+		events[i].Attempts++
+		events[i].NextAttemptAt = claimUntil
+	}
+	err = tx.Model(&model.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"status":          model.OutboxStatusProcessing,
+			"attempts":        gorm.Expr("attempts + 1"),
+			"next_attempt_at": claimUntil,
+		}).Error
+	if err != nil {
+		return nil, fmt.Errorf("outbox 이벤트 처리 중 표시 실패: %w", err)
+	}
+	return events, nil
+}
+
+// record saves the outcome of a delivered event. Nothing is saved when the claim expired and
+// another replica claimed the event again in the meantime.
+func (d *Dispatcher) record(ctx context.Context, event *model.OutboxEvent) error {
+	// Saved even when ctx was canceled by Stop, otherwise the event would be delivered again
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	result := d.db.WithContext(ctx).
+		Model(&model.OutboxEvent{}).
+		Where("id = ? AND status = ? AND attempts = ?", event.ID, model.OutboxStatusProcessing, event.Attempts).
+		Updates(map[string]any{
+			"status":          event.Status,
+			"next_attempt_at": event.NextAttemptAt,
+			"last_error":      event.LastError,
+			"processed_at":    event.ProcessedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("outbox 이벤트 상태 저장 실패: id=%d %w", event.ID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		d.logger.Warn("Outbox 이벤트 처리 시간 초과로 다른 인스턴스가 재처리 중 - 결과를 저장하지 않습니다",
+			"event_id", event.ID,
+			"claim_timeout", d.cfg.ClaimTimeout.String(),
+		)
+	}
+	return nil
+}
+
+// deliver invokes every handler for the event and updates its status in memory
+func (d *Dispatcher) deliver(ctx context.Context, event *model.OutboxEvent) {
+	log := d.logger.With("event_id", event.ID, "event_type", event.EventType, "attempt", event.Attempts)
+
+	var errs []error
+	for _, sub := range d.registry.handlers(event.EventType) {
+		if err := d.invoke(ctx, sub, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+		}
+	}
+
+	if len(errs) == 0 {
+		now := time.Now().UTC()
+		event.Status = model.OutboxStatusProcessed
+		event.ProcessedAt = &now
+		event.LastError = ""
+		log.Debug("Outbox 이벤트 처리 완료")
+		return
+	}
+
+	event.LastError = database.Truncate(errors.Join(errs...).Error(), database.MaxLastErrorLength)
+
+	if event.Attempts >= d.cfg.MaxAttempts {
+		event.Status = model.OutboxStatusDead
+		log.Error("Outbox 이벤트 dead-letter 처리", "error", event.LastError)
+		return
+	}
+
+	delay := d.backoff(event.Attempts)
+	event.Status = model.OutboxStatusPending
+	event.NextAttemptAt = time.Now().UTC().Add(delay)
+	log.Warn("Outbox 이벤트 처리 실패 - 재시도 예정", "error", event.LastError, "retry_in", delay.String())
+}
+
+// invoke calls a single handler, converting panics into errors
+func (d *Dispatcher) invoke(ctx context.Context, sub subscription, event *model.OutboxEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panic: %v", r)
+		}
+	}()
+
+	return sub.handle(ctx, []byte(event.Payload))
+}
+
+// backoff returns the delay before the next attempt (exponential, capped)
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.RetryBaseDelay << min(attempts-1, 30)
+	if delay <= 0 || delay > d.cfg.RetryMaxDelay {
+		return d.cfg.RetryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,166 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/member"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/outbox"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupDispatcher(t *testing.T, handler outbox.Handler[member.MemberSignedUp]) (*gorm.DB, *outbox.Dispatcher) {
+	t.Helper()
+
+	db := testutil.SetupTestDB(t)
+	t.Cleanup(func() {
+		testutil.CleanupTestDB(t, db)
+	})
+
+	registry := outbox.NewRegistry()
+	outbox.Subscribe(registry, "test", handler)
+
+	return db, outbox.NewDispatcher(db, registry, testutil.NewTestConfig().Outbox)
+}
+
+func publish(t *testing.T, db *gorm.DB, event outbox.Event) {
+	t.Helper()
+
+	err := database.WithTransaction(context.Background(), db, func(tx *gorm.DB) error {
+		return outbox.Publish(context.Background(), tx, event)
+	})
+	require.NoError(t, err)
+}
+
+func TestDispatcher_DeliversPublishedEvent(t *testing.T) {
+	// Given: a subscribed handler and a published event
+	var received []member.MemberSignedUp
+	db, dispatcher := setupDispatcher(t, func(ctx context.Context, evt member.MemberSignedUp) error {
+		received = append(received, evt)
+		return nil
+	})
+	publish(t, db, member.MemberSignedUp{MemberID: 7, Email: "test@example.com"})
+
+	// When
+	n, err := dispatcher.DispatchOnce(context.Background())
+
+	// Then: handler called with the typed payload and the row is processed
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Len(t, received, 1)
+	assert.Equal(t, uint32(7), received[0].MemberID)
+
+	var row model.OutboxEvent
+	require.NoError(t, db.First(&row).Error)
+	assert.Equal(t, model.OutboxStatusProcessed, row.Status)
+	assert.Equal(t, 1, row.Attempts)
+}
+
+func TestDispatcher_DeliversAfterClaimIsCommitted(t *testing.T) {
+	// Given: a handler that looks at its own row and polls again while it runs
+	var (
+		db         *gorm.DB
+		dispatcher *outbox.Dispatcher
+		status     string
+		reclaimed  int
+	)
+	db, dispatcher = setupDispatcher(t, func(ctx context.Context, evt member.MemberSignedUp) error {
+		var row model.OutboxEvent
+		if err := db.First(&row).Error; err != nil {
+			return err
+		}
+		status = row.Status
+
+		n, err := dispatcher.DispatchOnce(ctx)
+		reclaimed = n
+		return err
+	})
+	publish(t, db, member.MemberSignedUp{MemberID: 1})
+
+	// When
+	n, err := dispatcher.DispatchOnce(context.Background())
+
+	// Then: the claim was committed before delivery and the event is not claimed twice
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, model.OutboxStatusProcessing, status)
+	assert.Equal(t, 0, reclaimed)
+
+	var row model.OutboxEvent
+	require.NoError(t, db.First(&row).Error)
+	assert.Equal(t, model.OutboxStatusProcessed, row.Status)
+}
+
+func TestDispatcher_RolledBackEventIsNotDelivered(t *testing.T) {
+	called := false
+	db, dispatcher := setupDispatcher(t, func(ctx context.Context, evt member.MemberSignedUp) error {
+		called = true
+		return nil
+	})
+
+	// Given: the business transaction fails after publishing
+	_ = database.WithTransaction(context.Background(), db, func(tx *gorm.DB) error {
+		if err := outbox.Publish(context.Background(), tx, member.MemberSignedUp{MemberID: 1}); err != nil {
+			return err
+		}
+		return errors.New("business failure")
+	})
+
+	// When
+	n, err := dispatcher.DispatchOnce(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.False(t, called)
+}
+
+func TestDispatcher_DeadLettersAfterMaxAttempts(t *testing.T) {
+	// Given: a handler that always fails
+	db, dispatcher := setupDispatcher(t, func(ctx context.Context, evt member.MemberSignedUp) error {
+		return errors.New("smtp unavailable")
+	})
+	publish(t, db, member.MemberSignedUp{MemberID: 1})
+
+	// When: dispatch until attempts are exhausted (force rows due immediately)
+	maxAttempts := testutil.NewTestConfig().Outbox.MaxAttempts
+	for i := 0; i < maxAttempts; i++ {
+		require.NoError(t, db.Model(&model.OutboxEvent{}).Where("1 = 1").Update("next_attempt_at", gorm.Expr("created_at")).Error)
+		_, err := dispatcher.DispatchOnce(context.Background())
+		require.NoError(t, err)
+	}
+
+	// Then
+	var row model.OutboxEvent
+	require.NoError(t, db.First(&row).Error)
+	assert.Equal(t, model.OutboxStatusDead, row.Status)
+	assert.Equal(t, maxAttempts, row.Attempts)
+	assert.Contains(t, row.LastError, "smtp unavailable")
+}
+
+func TestDispatcher_FlushDeliversEveryDueEvent(t *testing.T) {
+	// Given: more events than one batch
+	delivered := 0
+	db, dispatcher := setupDispatcher(t, func(ctx context.Context, evt member.MemberSignedUp) error {
+		delivered++
+		return nil
+	})
+	batchSize := testutil.NewTestConfig().Outbox.BatchSize
+	for i := range batchSize + 5 {
+		publish(t, db, member.MemberSignedUp{MemberID: uint32(i + 1)})
+	}
+
+	// When
+	n, err := dispatcher.Flush(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, batchSize+5, n)
+	assert.Equal(t, batchSize+5, delivered)
+}
@@ -0,0 +1,73 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
+	"gorm.io/gorm"
+)
+
+// Publish writes the event to the outbox table using the given transaction.
+// The event is only delivered if the surrounding transaction commits.
+//
+// Usage:
+//
+//	err := database.WithTransaction(ctx, db, func(tx *gorm.DB) error {
+//	    if err := repo.Create(ctx, tx, member); err != nil {
+//	        return err
+//	    }
+//	    return outbox.Publish(ctx, tx, member.MemberSignedUp{MemberID: member.ID})
+//	})
+func Publish(ctx context.Context, tx *gorm.DB, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("이벤트 직렬화 실패: type=%s %w", event.EventType(), err)
+	}
+
+	row := &model.OutboxEvent{
+		EventType:     event.EventType(),
+		Payload:       string(payload),
+		Status:        model.OutboxStatusPending,
+		NextAttemptAt: time.Now().UTC(),
+	}
+
+	if err := tx.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("outbox 이벤트 저장 실패: type=%s %w", event.EventType(), err)
+	}
+
+	return nil
+}
+
+// PurgeProcessed deletes processed events older than the retention window.
+// Dead-lettered events are kept for manual inspection.
+func PurgeProcessed(ctx context.Context, db *gorm.DB, retention time.Duration) (int64, error) {
+	result := db.WithContext(ctx).
+		Where("status = ? AND processed_at < ?", model.OutboxStatusProcessed, time.Now().UTC().Add(-retention)).
+		Delete(&model.OutboxEvent{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("처리 완료 outbox 이벤트 삭제 실패: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// CheckLag returns an error when the oldest pending event was created more than maxLag ago,
+// i.e. the dispatcher is stuck or falling behind
+func CheckLag(ctx context.Context, db *gorm.DB, maxLag time.Duration) error {
+	var oldest model.OutboxEvent
+	err := db.WithContext(ctx).
+		Where("status IN ?", []string{model.OutboxStatusPending, model.OutboxStatusProcessing}).
+		Order("created_at").
+		Limit(1).
+		Find(&oldest).Error
+	if err != nil {
+		return fmt.Errorf("outbox 지연 조회 실패: %w", err)
+	}
+
+	if lag := time.Since(oldest.CreatedAt); oldest.ID != 0 && lag > maxLag {
+		return fmt.Errorf("outbox 처리 지연: 가장 오래된 미처리 이벤트 %s 경과 (허용 %s)", lag.Round(time.Second), maxLag)
+	}
+	return nil
+}
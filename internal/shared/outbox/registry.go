@@ -0,0 +1,78 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Event is a domain event that can be published through the outbox
+type Event interface {
+	// EventType returns a stable identifier stored with the event (e.g. "member.signed_up")
+	EventType() string
+}
+
+// Handler processes a typed event.
+// Delivery is at-least-once, so handlers must be idempotent.
+type Handler[T Event] func(ctx context.Context, event T) error
+
+// subscription is a type-erased handler bound to its name
+type subscription struct {
+	name   string
+	handle func(ctx context.Context, payload []byte) error
+}
+
+// Registry holds in-process subscriptions per event type
+type Registry struct {
+	mu            sync.RWMutex
+	subscriptions map[string][]subscription
+}
+
+// NewRegistry creates an empty event registry
+func NewRegistry() *Registry {
+	return &Registry{
+		subscriptions: make(map[string][]subscription),
+	}
+}
+
+// Subscribe registers a typed handler for events of type T.
+// name identifies the handler in logs and must be unique per event type.
+//
+// Usage:
+//
+//	outbox.Subscribe(registry, "auth.welcome", func(ctx context.Context, evt member.MemberSignedUp) error {
+//	    return mailer.SendWelcome(ctx, evt.Email)
+//	})
+func Subscribe[T Event](r *Registry, name string, handler Handler[T]) {
+	var zero T
+	eventType := zero.EventType()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, sub := range r.subscriptions[eventType] {
+		if sub.name == name {
+			panic(fmt.Sprintf("outbox: 중복 핸들러 등록 event=%s handler=%s", eventType, name))
+		}
+	}
+
+	r.subscriptions[eventType] = append(r.subscriptions[eventType], subscription{
+		name: name,
+		handle: func(ctx context.Context, payload []byte) error {
+			var event T
+			if err := json.Unmarshal(payload, &event); err != nil {
+				return fmt.Errorf("이벤트 역직렬화 실패: %w", err)
+			}
+			return handler(ctx, event)
+		},
+	})
+}
+
+// handlers returns the subscriptions registered for an event type
+func (r *Registry) handlers(eventType string) []subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.subscriptions[eventType]
+}
@@ -0,0 +1,18 @@
+// Package route holds the route groups modules register their endpoints on.
+package route
+
+import "github.com/gin-gonic/gin"
+
+// API is the set of route groups with their shared middleware already applied.
+// Modules only add endpoints; the middleware of each group is decided in one place (router.newAPI).
+type API struct {
+	Engine *gin.Engine // probes outside /api (/livez, /readyz, ...)
+
+	Meta    *gin.RouterGroup // /api/v1/meta, no client version check
+	Auth    *gin.RouterGroup // /api/v1/auth, client version + maintenance
+	Members *gin.RouterGroup // /api/v1/members, client version + maintenance + JWT + consent
+	Admin   *gin.RouterGroup // /api/v1/admin, X-Internal-Token only
+
+	// Idempotent makes a route honour the Idempotency-Key header
+	Idempotent gin.HandlerFunc
+}
@@ -26,6 +26,10 @@ func NewTestConfig() *config.Config {
 			ConnMaxLifetime: time.Hour,
 			ConnMaxIdleTime: 10 * time.Minute,
 			IsAutoMigrate:   true,
+
+			TxMaxAttempts:    3,
+			TxRetryBaseDelay: 10 * time.Millisecond,
+			TxRetryMaxDelay:  100 * time.Millisecond,
 		},
 		JWT: config.JWTConfig{
 			Secret:        "test-jwt-secret-key-must-be-at-least-32-characters-long",
@@ -33,9 +37,11 @@ func NewTestConfig() *config.Config {
 			RefreshExpiry: 168 * time.Hour,
 		},
 		CORS: config.CORSConfig{
-			AllowedOrigins:   []string{"*"},
+			Preset:           config.CORSPresetStrict,
+			AllowedOrigins:   []string{"https://app.example.com", "https://*.preview.example.com"},
 			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders:   []string{"*"},
+			AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "Idempotency-Key"},
+			ExposedHeaders:   []string{"X-Request-ID"},
 			AllowCredentials: true,
 			MaxAge:           86400,
 		},
@@ -44,6 +50,53 @@ func NewTestConfig() *config.Config {
 			WriteTimeout:    15 * time.Second,
 			IdleTimeout:     60 * time.Second,
 			GracefulTimeout: 30 * time.Second,
+			RequestTimeout:  30 * time.Second,
+			EnforceTimeout:  true,
+		},
+		Body: config.BodyConfig{
+			MaxBytes: 1 << 20,
+		},
+		Idempotency: config.IdempotencyConfig{
+			Store:   "memory",
+			TTL:     24 * time.Hour,
+			LockTTL: time.Minute,
+		},
+		Health: config.HealthConfig{
+			CheckTimeout: time.Second,
+			OutboxMaxLag: 5 * time.Minute,
+		},
+		Shutdown: config.ShutdownConfig{
+			StepTimeout:   5 * time.Second,
+			WorkerTimeout: 20 * time.Second,
+			FlushTimeout:  10 * time.Second,
+		},
+		Feature: config.FeatureConfig{
+			RetryAfter: 5 * time.Minute,
+		},
+		Internal: config.InternalConfig{
+			Token: "test-internal-token-must-be-at-least-32-characters",
+		},
+		Outbox: config.OutboxConfig{
+			PollInterval:   100 * time.Millisecond,
+			BatchSize:      50,
+			MaxAttempts:    3,
+			ClaimTimeout:   time.Minute,
+			RetryBaseDelay: 10 * time.Millisecond,
+			RetryMaxDelay:  100 * time.Millisecond,
+			PurgeSchedule:  "0 3 * * *",
+			Retention:      168 * time.Hour,
+		},
+		Job: config.JobConfig{
+			Workers:       2,
+			PollInterval:  100 * time.Millisecond,
+			LeaseTTL:      time.Minute,
+			MaxAttempts:   3,
+			RetryDelay:    10 * time.Millisecond,
+			PurgeSchedule: "30 3 * * *",
+			Retention:     168 * time.Hour,
+		},
+		Error: config.ErrorConfig{
+			Format: "legacy",
 		},
 	}
 }
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/model"
@@ -14,8 +15,22 @@ import (
 func SetupTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 
-	// Create in-memory SQLite database
-	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+	return openTestDB(t, ":memory:")
+}
+
+// SetupTestFileDB creates a file-backed SQLite database in a temp dir.
+// Use it when the code under test may drop pooled connections (e.g. cancelling a
+// transaction's context discards its connection, and with it an in-memory database).
+func SetupTestFileDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	return openTestDB(t, filepath.Join(t.TempDir(), "test.db"))
+}
+
+func openTestDB(t *testing.T, dsn string) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent), // Silent mode for tests
 	})
 	if err != nil {
@@ -25,6 +40,14 @@ func SetupTestDB(t *testing.T) *gorm.DB {
 	// Auto-migrate all models
 	err = db.AutoMigrate(
 		&model.Member{},
+		&model.OutboxEvent{},
+		&model.ScheduledJob{},
+		&model.JobLease{},
+		&model.IdempotencyRecord{},
+		&model.LegalDocument{},
+		&model.MemberConsent{},
+		&model.FeatureFlag{},
+		&model.MaintenanceWindow{},
 		// Add other models here as needed
 	)
 	if err != nil {
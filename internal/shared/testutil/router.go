@@ -7,25 +7,31 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/middleware"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/validator"
 	"github.com/gin-gonic/gin"
 )
 
-// SetupTestRouter creates a test Gin router without middleware
+// SetupTestRouter creates a test Gin router with only the error middleware
+// (needed to render errors returned by handler.Handle handlers)
 func SetupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 
 	// Register custom validators for testing
 	_ = validator.RegisterAll()
 
-	return gin.New()
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	return router
 }
 
 // MakeRequest is a helper to make HTTP requests in tests
 type TestRequest struct {
-	Method string
-	URL    string
-	Body   interface{}
+	Method  string
+	URL     string
+	Body    interface{}
+	RawBody []byte            // optional, sent as is instead of Body (e.g. gzip data)
+	Headers map[string]string // optional, e.g. Accept
 }
 
 // ExecuteRequest executes a test HTTP request and returns the response
@@ -40,9 +46,15 @@ func ExecuteRequest(t *testing.T, router *gin.Engine, req TestRequest) *httptest
 		}
 		bodyReader = bytes.NewReader(bodyBytes)
 	}
+	if req.RawBody != nil {
+		bodyReader = bytes.NewReader(req.RawBody)
+	}
 
 	httpReq := httptest.NewRequest(req.Method, req.URL, bodyReader)
 	httpReq.Header.Set("Content-Type", "application/json")
+	for key, value := range req.Headers {
+		httpReq.Header.Set(key, value)
+	}
 
 	recorder := httptest.NewRecorder()
 	router.ServeHTTP(recorder, httpReq)
@@ -2,6 +2,8 @@ package token
 
 import (
 	"errors"
+	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
@@ -12,8 +14,12 @@ var (
 	ErrInvalidToken  = errors.New("token: invalid token")
 	ErrExpiredToken  = errors.New("token: expired token")
 	ErrInvalidClaims = errors.New("token: invalid claims")
+	ErrWeakSecret    = errors.New("token: secret must be at least 32 characters")
 )
 
+// MinSecretLength is the minimum JWT secret length, also enforced by config validation
+const MinSecretLength = 32
+
 const (
 	ACCESS  = "access"
 	REFRESH = "refresh"
@@ -35,19 +41,52 @@ type Manager interface {
 }
 
 type JWTManager struct {
-	secret        []byte
+	keys          atomic.Pointer[signingKeys]
 	issuer        string
 	accessExpiry  time.Duration
 	refreshExpiry time.Duration
 }
 
+// signingKeys holds the current secret and the one it replaced.
+// Tokens signed with the previous secret stay valid until the next rotation or previousUntil,
+// when every token it signed has expired.
+type signingKeys struct {
+	current       []byte
+	previous      []byte
+	previousUntil time.Time
+}
+
 func NewJWTManager(cfg *config.Config) *JWTManager {
-	return &JWTManager{
-		secret:        []byte(cfg.JWT.Secret),
+	m := &JWTManager{
 		issuer:        cfg.App.Name,
 		accessExpiry:  cfg.JWT.Expiry,
 		refreshExpiry: cfg.JWT.RefreshExpiry,
 	}
+	m.keys.Store(&signingKeys{current: []byte(cfg.JWT.Secret)})
+
+	// Follow secret rotation (JWT_SECRET=file://... re-read at runtime)
+	cfg.OnSecretChange("jwt.secret", func(secret string) {
+		if err := m.RotateSecret(secret); err != nil {
+			slog.Error("JWT secret 교체 거부 - 기존 secret을 유지합니다", "error", err)
+		}
+	})
+	return m
+}
+
+// RotateSecret signs new tokens with secret while still accepting the previous one
+// for the refresh token lifetime. A secret shorter than MinSecretLength is rejected.
+func (m *JWTManager) RotateSecret(secret string) error {
+	if len(secret) < MinSecretLength {
+		return ErrWeakSecret
+	}
+
+	old := m.keys.Load()
+	m.keys.Store(&signingKeys{
+		current:       []byte(secret),
+		previous:      old.current,
+		previousUntil: time.Now().Add(max(m.accessExpiry, m.refreshExpiry)),
+	})
+	return nil
 }
 
 func (m *JWTManager) GenerateAccessToken(memberID, email string) (string, error) {
@@ -68,7 +107,7 @@ func (m *JWTManager) GenerateAccessToken(memberID, email string) (string, error)
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(m.secret)
+	return token.SignedString(m.keys.Load().current)
 }
 
 func (m *JWTManager) GenerateRefreshToken(memberID string, email string) (string, error) {
@@ -90,15 +129,16 @@ func (m *JWTManager) GenerateRefreshToken(memberID string, email string) (string
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(m.secret)
+	return token.SignedString(m.keys.Load().current)
 }
 
 func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
-	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+	keys := m.keys.Load()
 
-	token, err := parser.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return m.secret, nil
-	})
+	token, err := m.parse(tokenString, keys.current)
+	if errors.Is(err, jwt.ErrTokenSignatureInvalid) && keys.previous != nil && time.Now().Before(keys.previousUntil) {
+		token, err = m.parse(tokenString, keys.previous)
+	}
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, ErrExpiredToken
@@ -117,3 +157,11 @@ func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
 
 	return claims, nil
 }
+
+func (m *JWTManager) parse(tokenString string, secret []byte) (*jwt.Token, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+
+	return parser.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		return secret, nil
+	})
+}
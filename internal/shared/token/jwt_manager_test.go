@@ -0,0 +1,77 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/testutil"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/shared/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rotatedSecret = "rotated-jwt-secret-key-must-be-at-least-32-characters"
+	secondSecret  = "second-rotated-jwt-secret-key-at-least-32-characters"
+)
+
+func TestJWTManager_RotateSecret_SignsWithNewSecret(t *testing.T) {
+	// Given
+	manager := token.NewJWTManager(testutil.NewTestConfig())
+	require.NoError(t, manager.RotateSecret(rotatedSecret))
+
+	// When
+	accessToken, err := manager.GenerateAccessToken("1", "test@example.com")
+	require.NoError(t, err)
+
+	// Then: a manager that only knows the new secret accepts it
+	cfg := testutil.NewTestConfig()
+	cfg.JWT.Secret = rotatedSecret
+	claims, err := token.NewJWTManager(cfg).ValidateToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "1", claims.MemberID)
+}
+
+func TestJWTManager_RotateSecret_AcceptsPreviousSecret(t *testing.T) {
+	// Given: a refresh token signed before the rotation
+	manager := token.NewJWTManager(testutil.NewTestConfig())
+	refreshToken, err := manager.GenerateRefreshToken("1", "test@example.com")
+	require.NoError(t, err)
+
+	// When
+	require.NoError(t, manager.RotateSecret(rotatedSecret))
+
+	// Then
+	claims, err := manager.ValidateToken(refreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, token.REFRESH, claims.TokenType)
+}
+
+func TestJWTManager_RotateSecret_RejectsTokenAfterSecondRotation(t *testing.T) {
+	// Given: a token signed with the original secret
+	manager := token.NewJWTManager(testutil.NewTestConfig())
+	accessToken, err := manager.GenerateAccessToken("1", "test@example.com")
+	require.NoError(t, err)
+
+	// When: the secret rotates twice
+	require.NoError(t, manager.RotateSecret(rotatedSecret))
+	require.NoError(t, manager.RotateSecret(secondSecret))
+
+	// Then
+	_, err = manager.ValidateToken(accessToken)
+	assert.ErrorIs(t, err, token.ErrInvalidToken)
+}
+
+func TestJWTManager_RotateSecret_RejectsWeakSecret(t *testing.T) {
+	// Given
+	manager := token.NewJWTManager(testutil.NewTestConfig())
+	accessToken, err := manager.GenerateAccessToken("1", "test@example.com")
+	require.NoError(t, err)
+
+	// When
+	err = manager.RotateSecret("too-short")
+
+	// Then: the current secret is kept
+	assert.ErrorIs(t, err, token.ErrWeakSecret)
+	_, err = manager.ValidateToken(accessToken)
+	assert.NoError(t, err)
+}
@@ -2,13 +2,16 @@ package validator
 
 import (
 	"errors"
-	"fmt"
 
 	sharedError "github.com/changhyeonkim/pray-together/go-api-server/internal/shared/error"
 	"github.com/go-playground/validator/v10"
 )
 
+// messageTags are the validation tags with a dedicated message (validation.<tag>)
+var messageTags = []string{"required", "email", "min", "max", "phone"}
+
 // ToErrorResponse converts gin binding/validator errors into a standardized response.
+// Messages are rendered per request locale by sharedError.Localize.
 func ToErrorResponse(err error) (*sharedError.ErrorResponse, bool) {
 	var validationErrors validator.ValidationErrors
 	if !errors.As(err, &validationErrors) {
@@ -19,29 +22,37 @@ func ToErrorResponse(err error) (*sharedError.ErrorResponse, bool) {
 		return nil, false
 	}
 
-	// 첫 번째 validation error만 반환 (사용자 친화적)
-	fieldErr := validationErrors[0]
-	message := getErrorMessage(fieldErr)
+	// message: 첫 번째 validation error (사용자 친화적), errors: 전체 목록 (problem+json)
+	fieldErrors := make([]sharedError.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		fieldErrors = append(fieldErrors, sharedError.FieldError{
+			Field:      fe.Field(), // JSON 필드명 (RegisterAll 의 tag name func)
+			Tag:        fe.Tag(),
+			Param:      fe.Param(),
+			MessageKey: messageKey(fe.Tag()),
+		})
+	}
 
 	resp := sharedError.ValidationFailed
-	resp.Message = message
+	resp.Errors = fieldErrors
 	return &resp, true
 }
 
-// getErrorMessage returns user-friendly error message for validation error
-func getErrorMessage(fe validator.FieldError) string {
-	switch fe.Tag() {
-	case "required":
-		return "필수 항목을 입력해 주세요."
-	case "email":
-		return "이메일 형식이 올바르지 않습니다."
-	case "min":
-		return fmt.Sprintf("최소 %s자 이상이어야 합니다.", fe.Param())
-	case "max":
-		return fmt.Sprintf("최대 %s자까지 입력 가능합니다.", fe.Param())
-	case "phone":
-		return "휴대폰 번호 형식이 올바르지 않습니다. (010-XXXX-XXXX)"
-	default:
-		return fmt.Sprintf("'%s' 필드가 올바르지 않습니다.", fe.Field())
+// messageKey returns the i18n key of the user-friendly message for a validation tag
+func messageKey(tag string) string {
+	for _, t := range messageTags {
+		if t == tag {
+			return "validation." + tag
+		}
+	}
+	return "validation.default"
+}
+
+// MessageKeys returns every i18n key used for validation messages
+func MessageKeys() []string {
+	keys := make([]string, 0, len(messageTags)+1)
+	for _, tag := range messageTags {
+		keys = append(keys, messageKey(tag))
 	}
+	return append(keys, "validation.default")
 }
@@ -2,9 +2,12 @@ package validator
 
 import (
 	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
-	"log/slog"
 )
 
 // GetValidator returns the validator instance from Gin binding
@@ -24,6 +27,9 @@ func RegisterAll() error {
 		return fmt.Errorf("validator 엔진 가져오기 실패: %w", err)
 	}
 
+	// Report fields by the name clients send (json, or form for query parameters)
+	v.RegisterTagNameFunc(fieldName)
+
 	// Register common validators
 	if err := v.RegisterValidation("phone", ValidatePhone); err != nil {
 		return fmt.Errorf("phone validator 등록 실패: %w", err)
@@ -32,3 +38,17 @@ func RegisterAll() error {
 	slog.Info("공통 Validator 등록 완료", "validators", "phone")
 	return nil
 }
+
+// fieldName returns the json (or form) tag name of a struct field, falling back to the Go name
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return field.Name
+}